@@ -3,9 +3,11 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"os"
 	"reflect"
 	"runtime/debug"
 	"strings"
+	"syscall"
 
 	"errors"
 
@@ -62,6 +64,10 @@ type BaseApp struct {
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// databases of the stores mounted by MountStores by store key name,
+	// instead of the common DB; entries are removed once mounted
+	storeDBs map[string]dbm.DB
+
 	// --------------------
 	// Volatile state
 	// checkState is set on initialization and reset on Commit.
@@ -79,6 +85,12 @@ type BaseApp struct {
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// The block height and time (UNIX Epoch time) at which the node halts after
+	// committing the block, e.g. to perform a coordinated upgrade. Zero values
+	// disable halting.
+	haltHeight uint64
+	haltTime   uint64
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 }
@@ -133,12 +145,15 @@ func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
 	for _, key := range keys {
 		switch key.(type) {
 		case *sdk.KVStoreKey:
+			db := app.storeDBs[key.Name()]
+			delete(app.storeDBs, key.Name())
+
 			if !app.fauxMerkleMode {
-				app.MountStore(key, sdk.StoreTypeIAVL)
+				app.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
 			} else {
 				// StoreTypeDB doesn't do anything upon commit, and it doesn't
 				// retain history, but it's useful for faster simulation.
-				app.MountStore(key, sdk.StoreTypeDB)
+				app.MountStoreWithDB(key, sdk.StoreTypeDB, db)
 			}
 		case *sdk.TransientStoreKey:
 			app.MountStore(key, sdk.StoreTypeTransient)
@@ -163,6 +178,9 @@ func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
 // LoadLatestVersion loads the latest application version. It will panic if
 // called more than once on a running BaseApp.
 func (app *BaseApp) LoadLatestVersion(baseKey *sdk.KVStoreKey) error {
+	if err := app.checkStoreDBs(); err != nil {
+		return err
+	}
 	err := app.cms.LoadLatestVersion()
 	if err != nil {
 		return err
@@ -173,6 +191,9 @@ func (app *BaseApp) LoadLatestVersion(baseKey *sdk.KVStoreKey) error {
 // LoadVersion loads the BaseApp application version. It will panic if called
 // more than once on a running baseapp.
 func (app *BaseApp) LoadVersion(version int64, baseKey *sdk.KVStoreKey) error {
+	if err := app.checkStoreDBs(); err != nil {
+		return err
+	}
 	err := app.cms.LoadVersion(version)
 	if err != nil {
 		return err
@@ -180,6 +201,15 @@ func (app *BaseApp) LoadVersion(version int64, baseKey *sdk.KVStoreKey) error {
 	return app.initFromMainStore(baseKey)
 }
 
+// checkStoreDBs returns an error if a store database was set for a store key
+// name that MountStores did not mount.
+func (app *BaseApp) checkStoreDBs() error {
+	for name := range app.storeDBs {
+		return fmt.Errorf("a database is set for store %s, but no such store is mounted", name)
+	}
+	return nil
+}
+
 // LastCommitID returns the last CommitID of the multistore.
 func (app *BaseApp) LastCommitID() sdk.CommitID {
 	return app.cms.LastCommitID()
@@ -190,6 +220,38 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// Snapshot writes a snapshot of the application state committed at the given
+// height to w, and returns the CommitID of that height.
+func (app *BaseApp) Snapshot(height int64, w io.Writer) (sdk.CommitID, error) {
+	snapshotter, ok := app.cms.(sdk.Snapshotter)
+	if !ok {
+		return sdk.CommitID{}, errors.New("multistore does not support snapshots")
+	}
+	return snapshotter.Snapshot(height, w)
+}
+
+// Restore restores the application state from a snapshot, failing unless the
+// restored state matches the given CommitID. The multistore must be empty,
+// and the application has to be restarted to serve the restored state.
+func (app *BaseApp) Restore(id sdk.CommitID, r io.Reader) error {
+	snapshotter, ok := app.cms.(sdk.Snapshotter)
+	if !ok {
+		return errors.New("multistore does not support snapshots")
+	}
+	return snapshotter.Restore(id, r)
+}
+
+// Rollback reverts the application state to the given committed height,
+// deleting the state of all later heights. The application has to be
+// restarted to serve the reverted state.
+func (app *BaseApp) Rollback(height int64) error {
+	rollbacker, ok := app.cms.(sdk.Rollbacker)
+	if !ok {
+		return errors.New("multistore does not support rollbacks")
+	}
+	return rollbacker.Rollback(height)
+}
+
 // initializes the remaining logic from app.cms
 func (app *BaseApp) initFromMainStore(baseKey *sdk.KVStoreKey) error {
 	mainStore := app.cms.GetKVStore(baseKey)
@@ -230,6 +292,21 @@ func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
 
+func (app *BaseApp) setHaltHeight(haltHeight uint64) {
+	app.haltHeight = haltHeight
+}
+
+func (app *BaseApp) setHaltTime(haltTime uint64) {
+	app.haltTime = haltTime
+}
+
+func (app *BaseApp) setStoreDBs(dbs map[string]dbm.DB) {
+	app.storeDBs = make(map[string]dbm.DB, len(dbs))
+	for name, db := range dbs {
+		app.storeDBs[name] = db
+	}
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() Router {
 	if app.sealed {
@@ -419,12 +496,20 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 
 		switch path[1] {
 		case "simulate":
+			var simRes sdk.SimulationResponse
+
 			txBytes := req.Data
 			tx, err := app.txDecoder(txBytes)
 			if err != nil {
-				result = err.Result()
+				simRes.Result = err.Result()
 			} else {
-				result = app.Simulate(txBytes, tx)
+				simRes = app.SimulateWithGasTrace(txBytes, tx)
+			}
+
+			return abci.ResponseQuery{
+				Code:      uint32(sdk.CodeOK),
+				Codespace: string(sdk.CodespaceRoot),
+				Value:     codec.Cdc.MustMarshalBinaryLengthPrefixed(simRes),
 			}
 
 		case "version":
@@ -434,6 +519,18 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				Value:     []byte(version.Version),
 			}
 
+		case "pruning":
+			reporter, ok := app.cms.(sdk.PruningReporter)
+			if !ok {
+				return sdk.ErrUnknownRequest("multistore doesn't report pruning status").QueryResult()
+			}
+
+			return abci.ResponseQuery{
+				Code:      uint32(sdk.CodeOK),
+				Codespace: string(sdk.CodespaceRoot),
+				Value:     codec.Cdc.MustMarshalJSON(reporter.PruningStatus()),
+			}
+
 		default:
 			result = sdk.ErrUnknownRequest(fmt.Sprintf("Unknown query: %s", path)).Result()
 		}
@@ -446,7 +543,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 		}
 	}
 
-	msg := "Expected second parameter to be either simulate or version, neither was present"
+	msg := "Expected second parameter to be one of simulate, version or pruning, none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
@@ -499,10 +596,37 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 		return sdk.ErrUnknownRequest(fmt.Sprintf("no custom querier found for route %s", path[1])).QueryResult()
 	}
 
+	// queries without a height are answered from the latest state
+	lastHeight := app.LastBlockHeight()
+	if req.Height == 0 {
+		req.Height = lastHeight
+	}
+	if req.Height < 0 || req.Height > lastHeight {
+		msg := fmt.Sprintf("cannot query at height %d; latest height is %d", req.Height, lastHeight)
+		return sdk.ErrUnknownRequest(msg).QueryResult()
+	}
+
 	// cache wrap the commit-multistore for safety
-	ctx := sdk.NewContext(
-		app.cms.CacheMultiStore(), app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	//
+	// NOTE: Only the header of the latest block is known. Past heights are
+	// queried with a header carrying only the chain ID and height, so queriers
+	// depending on the block time see a zero time rather than the latest one.
+	header := app.checkState.ctx.BlockHeader()
+	cacheMS := app.cms.CacheMultiStore()
+	if req.Height != lastHeight {
+		var err error
+		cacheMS, err = app.cms.CacheMultiStoreWithVersion(req.Height)
+		if err != nil {
+			msg := fmt.Sprintf(
+				"failed to load state at height %d, it may have been pruned; latest height is %d: %s",
+				req.Height, lastHeight, err,
+			)
+			return sdk.ErrUnknownRequest(msg).QueryResult()
+		}
+		header = abci.Header{ChainID: header.ChainID, Height: req.Height}
+	}
+
+	ctx := sdk.NewContext(cacheMS, header, true, app.logger).WithMinGasPrices(app.minGasPrices)
 
 	// Passes the rest of the path as an argument to the querier.
 	//
@@ -518,8 +642,9 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 	}
 
 	return abci.ResponseQuery{
-		Code:  uint32(sdk.CodeOK),
-		Value: resBytes,
+		Code:   uint32(sdk.CodeOK),
+		Value:  resBytes,
+		Height: req.Height,
 	}
 }
 
@@ -548,6 +673,13 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		panic(err)
 	}
 
+	// Refuse to go past the halt height or halt time, in case the node was
+	// restarted with the same configuration or the halt signal sent on commit
+	// has not stopped it yet.
+	if err := app.validateHalt(req); err != nil {
+		panic(err)
+	}
+
 	// Initialize the DeliverTx state. If this is the first block, it should
 	// already be initialized in InitChain. Otherwise app.deliverState will be
 	// nil, since it is reset on Commit.
@@ -593,7 +725,7 @@ func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 	if err != nil {
 		result = err.Result()
 	} else {
-		result = app.runTx(runTxModeCheck, txBytes, tx)
+		result = app.runTx(runTxModeCheck, txBytes, tx, nil)
 	}
 
 	return abci.ResponseCheckTx{
@@ -614,7 +746,7 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 	if err != nil {
 		result = err.Result()
 	} else {
-		result = app.runTx(runTxModeDeliver, txBytes, tx)
+		result = app.runTx(runTxModeDeliver, txBytes, tx, nil)
 	}
 
 	return abci.ResponseDeliverTx{
@@ -669,6 +801,10 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 	var codespace sdk.CodespaceType
 
 	for msgIdx, msg := range msgs {
+		if gasTracer := ctx.GasTracer(); gasTracer != nil {
+			gasTracer.SetMsgIndex(msgIdx)
+		}
+
 		// match message route
 		msgRoute := msg.Route()
 		handler := app.router.Route(msgRoute)
@@ -751,10 +887,11 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (
 }
 
 // runTx processes a transaction. The transactions is proccessed via an
-// anteHandler. The provided txBytes may be nil in some cases, eg. in tests. For
+// anteHandler. The provided txBytes may be nil in some cases, eg. in tests. If
+// gasTracer is not nil, it records the gas consumed by the transaction. For
 // further details on transaction execution, reference the BaseApp SDK
 // documentation.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk.Result) {
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx, gasTracer *sdk.GasTracer) (result sdk.Result) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
@@ -763,6 +900,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	if gasTracer != nil {
+		ctx = ctx.WithGasTracer(gasTracer)
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		result = sdk.ErrOutOfGas("no block gas left to run tx").Result()
@@ -903,11 +1044,72 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	// Halt the node once the block is committed and let Tendermint receive the
+	// commit response, so that the node restarts from a consistent state.
+	if app.haltReached(header) {
+		app.halt()
+	}
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
 }
 
+// haltReached returns whether the configured halt height or halt time has been
+// reached by the block with the given header.
+func (app *BaseApp) haltReached(header abci.Header) bool {
+	switch {
+	case app.haltHeight > 0 && uint64(header.Height) >= app.haltHeight:
+		return true
+
+	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
+		return true
+
+	default:
+		return false
+	}
+}
+
+// validateHalt returns an error if the block is past the configured halt
+// height, or follows a committed block that reached the configured halt time.
+// The time of the last committed block is only known until the node restarts,
+// after which the next block is processed and the node halts again on commit.
+func (app *BaseApp) validateHalt(req abci.RequestBeginBlock) error {
+	if app.haltHeight > 0 && uint64(req.Header.Height) > app.haltHeight {
+		return fmt.Errorf("refusing block %d past the halt height %d", req.Header.Height, app.haltHeight)
+	}
+
+	lastTime := app.checkState.ctx.BlockHeader().Time
+	if app.haltTime > 0 && !lastTime.IsZero() && lastTime.Unix() >= int64(app.haltTime) {
+		return fmt.Errorf("refusing block %d past the halt time %d", req.Header.Height, app.haltTime)
+	}
+
+	return nil
+}
+
+// halt stops the node by sending an interrupt signal to its own process, which
+// gracefully shuts down Tendermint and the application. It exits the process if
+// the signal cannot be sent.
+func (app *BaseApp) halt() {
+	app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
+
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		// attempt cascading signals in case SIGINT fails (os dependent)
+		sigIntErr := p.Signal(syscall.SIGINT)
+		sigTermErr := p.Signal(syscall.SIGTERM)
+
+		if sigIntErr == nil || sigTermErr == nil {
+			return
+		}
+	}
+
+	// resort to exiting immediately if the process could not be found or killed
+	// via SIGINT/SIGTERM signals
+	app.logger.Info("failed to send SIGINT/SIGTERM; exiting...")
+	os.Exit(0)
+}
+
 // ----------------------------------------------------------------------------
 // State
 
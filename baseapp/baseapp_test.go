@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	store "github.com/cosmos/cosmos-sdk/store/types"
 
@@ -165,6 +166,48 @@ func TestLoadVersionInvalid(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSetStoreDBs(t *testing.T) {
+	logger := defaultLogger()
+	db, storeDB := dbm.NewMemDB(), dbm.NewMemDB()
+	name := t.Name()
+	capKey := sdk.NewKVStoreKey(MainStoreKey)
+	storeKey := sdk.NewKVStoreKey("store")
+	storeDBs := SetStoreDBs(map[string]dbm.DB{"store": storeDB})
+
+	app := NewBaseApp(name, logger, db, nil, storeDBs)
+	app.MountStores(capKey, storeKey)
+	err := app.LoadLatestVersion(capKey)
+	require.Nil(t, err)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.deliverState.ctx.KVStore(storeKey).Set([]byte("foo"), []byte("bar"))
+	res := app.Commit()
+	commitID := sdk.CommitID{1, res.Data}
+
+	// the state of the store is written to its own database only
+	it := storeDB.Iterator(nil, nil)
+	require.True(t, it.Valid())
+	it.Close()
+	it = dbm.IteratePrefix(db, []byte("s/k:store/"))
+	require.False(t, it.Valid())
+	it.Close()
+
+	// reload with the same databases
+	app = NewBaseApp(name, logger, db, nil, storeDBs)
+	app.MountStores(capKey, storeKey)
+	err = app.LoadLatestVersion(capKey)
+	require.Nil(t, err)
+	testLoadVersionHelper(t, app, int64(1), commitID)
+	require.Equal(t, []byte("bar"), app.checkState.ctx.KVStore(storeKey).Get([]byte("foo")))
+
+	// require error when a database is set for a store that is not mounted
+	app = NewBaseApp(name, logger, db, nil, storeDBs)
+	app.MountStores(capKey)
+	err = app.LoadLatestVersion(capKey)
+	require.Error(t, err)
+}
+
 func testLoadVersionHelper(t *testing.T, app *BaseApp, expectedHeight int64, expectedID sdk.CommitID) {
 	lastHeight := app.LastBlockHeight()
 	lastID := app.LastCommitID()
@@ -261,6 +304,60 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, app.minGasPrices)
 }
 
+func TestHaltReached(t *testing.T) {
+	haltTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		haltHeight uint64
+		haltTime   uint64
+		header     abci.Header
+		expected   bool
+	}{
+		{0, 0, abci.Header{Height: 10, Time: haltTime}, false},
+		{10, 0, abci.Header{Height: 9, Time: haltTime}, false},
+		{10, 0, abci.Header{Height: 10, Time: haltTime}, true},
+		{10, 0, abci.Header{Height: 11, Time: haltTime}, true},
+		{0, uint64(haltTime.Unix()), abci.Header{Height: 10, Time: haltTime.Add(-time.Second)}, false},
+		{0, uint64(haltTime.Unix()), abci.Header{Height: 10, Time: haltTime}, true},
+		{0, uint64(haltTime.Unix()), abci.Header{Height: 10, Time: haltTime.Add(time.Second)}, true},
+		{20, uint64(haltTime.Unix()), abci.Header{Height: 10, Time: haltTime}, true},
+		{10, uint64(haltTime.Unix()), abci.Header{Height: 10, Time: haltTime.Add(-time.Second)}, true},
+	}
+
+	for i, tc := range testCases {
+		app := newBaseApp(t.Name(), SetHaltHeight(tc.haltHeight), SetHaltTime(tc.haltTime))
+		require.Equal(t, tc.expected, app.haltReached(tc.header), "test case #%d", i)
+	}
+}
+
+func TestBeginBlockPastHalt(t *testing.T) {
+	haltTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// commit a first block before configuring the halt, as committing the
+	// block reaching it would signal the test process
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+	header := abci.Header{Height: 1, Time: haltTime}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	next := abci.RequestBeginBlock{Header: abci.Header{Height: 2, Time: haltTime.Add(time.Second)}}
+
+	// blocks past the halt height are refused
+	app.setHaltHeight(1)
+	require.Panics(t, func() { app.BeginBlock(next) })
+	app.setHaltHeight(2)
+	require.NotPanics(t, func() { app.BeginBlock(next) })
+	app.setHaltHeight(0)
+
+	// blocks following a block that reached the halt time are refused
+	app.setHaltTime(uint64(haltTime.Unix()))
+	require.Panics(t, func() { app.BeginBlock(next) })
+	app.setHaltTime(uint64(haltTime.Add(time.Second).Unix()))
+	require.NotPanics(t, func() { app.BeginBlock(next) })
+}
+
 func TestInitChainer(t *testing.T) {
 	name := t.Name()
 	// keep the db and logger ourselves so
@@ -719,6 +816,13 @@ func TestSimulateTx(t *testing.T) {
 		require.True(t, result.IsOK(), result.Log)
 		require.Equal(t, gasConsumed, result.GasUsed)
 
+		// simulate with a gas trace, which itemizes the gas used by the message
+		expectedTrace := sdk.GasTrace{{MsgIndex: 0, Descriptor: "test", Gas: gasConsumed}}
+		simRes := app.SimulateWithGasTrace(txBytes, tx)
+		require.True(t, simRes.Result.IsOK(), simRes.Result.Log)
+		require.Equal(t, gasConsumed, simRes.Result.GasUsed)
+		require.Equal(t, expectedTrace, simRes.GasTrace)
+
 		// simulate by calling Query with encoded tx
 		query := abci.RequestQuery{
 			Path: "/app/simulate",
@@ -727,11 +831,12 @@ func TestSimulateTx(t *testing.T) {
 		queryResult := app.Query(query)
 		require.True(t, queryResult.IsOK(), queryResult.Log)
 
-		var res sdk.Result
+		var res sdk.SimulationResponse
 		codec.Cdc.MustUnmarshalBinaryLengthPrefixed(queryResult.Value, &res)
 		require.Nil(t, err, "Result unmarshalling failed")
-		require.True(t, res.IsOK(), res.Log)
-		require.Equal(t, gasConsumed, res.GasUsed, res.Log)
+		require.True(t, res.Result.IsOK(), res.Result.Log)
+		require.Equal(t, gasConsumed, res.Result.GasUsed, res.Result.Log)
+		require.Equal(t, expectedTrace, res.GasTrace)
 		app.EndBlock(abci.RequestEndBlock{})
 		app.Commit()
 	}
@@ -1207,6 +1312,87 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+// Test that custom queries are answered from the state at the requested height.
+func TestQueryCustomHeight(t *testing.T) {
+	key := []byte("height")
+	beginBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set(key, []byte(fmt.Sprintf("%d", req.Header.Height)))
+			return abci.ResponseBeginBlock{}
+		})
+	}
+	querierOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("height", func(ctx sdk.Context, _ []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+			return ctx.KVStore(capKey1).Get(key), nil
+		})
+		bapp.QueryRouter().AddRoute("time", func(ctx sdk.Context, _ []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+			return []byte(fmt.Sprintf("%d", ctx.BlockHeader().Time.Unix())), nil
+		})
+	}
+
+	app := setupBaseApp(t, SetPruning(store.NewPruningOptions(2, 0)), beginBlockerOpt, querierOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	blockTime := time.Unix(1000, 0)
+	for height := int64(1); height <= 5; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height, Time: blockTime}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	// the latest state is queried by default
+	res := app.Query(abci.RequestQuery{Path: "/custom/height"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("5"), res.Value)
+	require.Equal(t, int64(5), res.Height)
+
+	for height := int64(3); height <= 5; height++ {
+		res = app.Query(abci.RequestQuery{Path: "/custom/height", Height: height})
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, []byte(fmt.Sprintf("%d", height)), res.Value)
+		require.Equal(t, height, res.Height)
+	}
+
+	// only the time of the latest block is known
+	res = app.Query(abci.RequestQuery{Path: "/custom/time"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte(fmt.Sprintf("%d", blockTime.Unix())), res.Value)
+	res = app.Query(abci.RequestQuery{Path: "/custom/time", Height: 4})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte(fmt.Sprintf("%d", time.Time{}.Unix())), res.Value)
+
+	// pruned and future heights can't be queried
+	res = app.Query(abci.RequestQuery{Path: "/custom/height", Height: 1})
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "pruned")
+	res = app.Query(abci.RequestQuery{Path: "/custom/height", Height: 6})
+	require.False(t, res.IsOK())
+}
+
+func TestQueryPruningStatus(t *testing.T) {
+	pruning := store.NewPruningOptions(2, 3).WithInterval(2)
+	app := setupBaseApp(t, SetPruning(pruning))
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 5; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	res := app.Query(abci.RequestQuery{Path: "/app/pruning"})
+	require.True(t, res.IsOK(), res.Log)
+
+	var status sdk.PruningStatus
+	require.NoError(t, codec.Cdc.UnmarshalJSON(res.Value, &status))
+	require.Equal(t, sdk.PruningStatus{
+		KeepRecent:   2,
+		KeepEvery:    3,
+		Interval:     2,
+		PrunedHeight: 4,
+	}, status)
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
@@ -12,17 +12,25 @@ var isAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
 
 // nolint - Mostly for testing
 func (app *BaseApp) Check(tx sdk.Tx) (result sdk.Result) {
-	return app.runTx(runTxModeCheck, nil, tx)
+	return app.runTx(runTxModeCheck, nil, tx, nil)
 }
 
 // nolint - full tx execution
 func (app *BaseApp) Simulate(txBytes []byte, tx sdk.Tx) (result sdk.Result) {
-	return app.runTx(runTxModeSimulate, txBytes, tx)
+	return app.runTx(runTxModeSimulate, txBytes, tx, nil)
+}
+
+// SimulateWithGasTrace simulates a transaction like Simulate, and returns the
+// itemized gas it consumed along with the result.
+func (app *BaseApp) SimulateWithGasTrace(txBytes []byte, tx sdk.Tx) sdk.SimulationResponse {
+	tracer := sdk.NewGasTracer()
+	result := app.runTx(runTxModeSimulate, txBytes, tx, tracer)
+	return sdk.SimulationResponse{Result: result, GasTrace: tracer.Trace()}
 }
 
 // nolint
 func (app *BaseApp) Deliver(tx sdk.Tx) (result sdk.Result) {
-	return app.runTx(runTxModeDeliver, nil, tx)
+	return app.runTx(runTxModeDeliver, nil, tx, nil)
 }
 
 // Context with current {check, deliver}State of the app
@@ -18,6 +18,12 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
 }
 
+// SetStoreDBs returns an option that sets the databases of the stores mounted
+// by MountStores by store key name, instead of the common DB.
+func SetStoreDBs(dbs map[string]dbm.DB) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setStoreDBs(dbs) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -28,6 +34,18 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetHaltHeight returns an option that sets the height at which the node halts
+// after committing the block.
+func SetHaltHeight(haltHeight uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltHeight(haltHeight) }
+}
+
+// SetHaltTime returns an option that sets the time (UNIX Epoch time) at or
+// after which the node halts after committing the block.
+func SetHaltTime(haltTime uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
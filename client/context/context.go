@@ -203,6 +203,13 @@ func (ctx CLIContext) WithNodeURI(nodeURI string) CLIContext {
 	return ctx
 }
 
+// WithHeight returns a copy of the context with an updated height to query
+// state at.
+func (ctx CLIContext) WithHeight(height int64) CLIContext {
+	ctx.Height = height
+	return ctx
+}
+
 // WithClient returns a copy of the context with an updated RPC client
 // instance.
 func (ctx CLIContext) WithClient(client rpcclient.Client) CLIContext {
@@ -48,15 +48,60 @@ func (ctx CLIContext) QueryStore(key cmn.HexBytes, storeName string) (res []byte
 }
 
 // QuerySubspace performs a query from a Tendermint node with the provided
-// store name and subspace.
+// store name and subspace. The KV pairs under the subspace are fetched in pages,
+// all at the same height, and verified against range proofs unless the node is
+// trusted.
 func (ctx CLIContext) QuerySubspace(subspace []byte, storeName string) (res []sdk.KVPair, err error) {
-	resRaw, err := ctx.queryStore(subspace, storeName, "subspace")
+	query := sdk.SubspaceQuery{
+		Start: subspace,
+		End:   sdk.PrefixEndBytes(subspace),
+		Limit: sdk.MaxSubspaceQueryLimit,
+	}
+
+	for {
+		kvs, height, err := ctx.querySubspacePage(query, storeName)
+		if err != nil {
+			return res, err
+		}
+
+		res = append(res, kvs...)
+		if len(kvs) < query.PageSize() {
+			return res, nil
+		}
+
+		// continue right after the last key, at the height of the first page
+		ctx.Height = height
+		last := kvs[len(kvs)-1].Key
+		query.Start = append(append(make([]byte, 0, len(last)+1), last...), 0)
+	}
+}
+
+// QuerySubspacePage performs a query from a Tendermint node with the provided
+// store name, returning a single page of the KV pairs selected by the subspace
+// query. The page is verified against a range proof unless the node is trusted.
+func (ctx CLIContext) QuerySubspacePage(query sdk.SubspaceQuery, storeName string) ([]sdk.KVPair, error) {
+	kvs, _, err := ctx.querySubspacePage(query, storeName)
+	return kvs, err
+}
+
+// querySubspacePage performs a subspace query and returns the KV pairs along
+// with the height they were read at.
+func (ctx CLIContext) querySubspacePage(query sdk.SubspaceQuery, storeName string) (res []sdk.KVPair, height int64, err error) {
+	bz, err := ctx.Codec.MarshalBinaryBare(query)
 	if err != nil {
-		return res, err
+		return res, 0, err
+	}
+
+	resp, err := ctx.queryABCI(fmt.Sprintf("/store/%s/subspace", storeName), bz)
+	if err != nil {
+		return res, 0, err
+	}
+
+	if err := ctx.Codec.UnmarshalBinaryLengthPrefixed(resp.Value, &res); err != nil {
+		return res, 0, err
 	}
 
-	ctx.Codec.MustUnmarshalBinaryLengthPrefixed(resRaw, &res)
-	return
+	return res, resp.Height, nil
 }
 
 // GetAccount queries for an account given an address and a block height. An
@@ -144,14 +189,73 @@ func (ctx CLIContext) queryAccount(addr sdk.AccAddress) ([]byte, error) {
 	return res, nil
 }
 
+// provableQueries holds the declarations of the store keys backing custom
+// queries by query path.
+var provableQueries = make(map[string]sdk.ProvableQuery)
+
+// RegisterProvableQueries registers the declarations of the store keys backing
+// custom queries by query path, e.g. "custom/acc/account". Unless the node is
+// trusted, the results of these queries are rebuilt from the values of their
+// keys fetched with Merkle proofs.
+func RegisterProvableQueries(queries map[string]sdk.ProvableQuery) {
+	for path, query := range queries {
+		provableQueries[strings.TrimPrefix(path, "/")] = query
+	}
+}
+
 // query performs a query from a Tendermint node with the provided store name
 // and path.
 func (ctx CLIContext) query(path string, key cmn.HexBytes) (res []byte, err error) {
-	node, err := ctx.GetNode()
+	if query, ok := provableQueries[strings.TrimPrefix(path, "/")]; ok && !ctx.TrustNode {
+		return ctx.queryProvable(query, key)
+	}
+
+	resp, err := ctx.queryABCI(path, key)
 	if err != nil {
 		return res, err
 	}
 
+	return resp.Value, nil
+}
+
+// queryProvable fetches the values of the keys backing a custom query with
+// Merkle proofs, all at the same height, and rebuilds the result from them.
+func (ctx CLIContext) queryProvable(query sdk.ProvableQuery, data []byte) ([]byte, error) {
+	keys, err := query.Keys(data)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/store/%s/key", query.Store)
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		resp, err := ctx.queryABCI(path, key)
+		if err != nil {
+			return nil, err
+		}
+
+		// read the remaining keys at the height of the first one
+		ctx.Height = resp.Height
+		values[i] = resp.Value
+	}
+
+	res, sdkErr := query.Result(data, values)
+	if sdkErr != nil {
+		return nil, errors.New(sdkErr.ABCILog())
+	}
+
+	return res, nil
+}
+
+// queryABCI performs an ABCI query from a Tendermint node with the provided
+// path and data, verifying the proof of store queries unless the node is
+// trusted.
+func (ctx CLIContext) queryABCI(path string, key cmn.HexBytes) (resp abci.ResponseQuery, err error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return resp, err
+	}
+
 	opts := rpcclient.ABCIQueryOptions{
 		Height: ctx.Height,
 		Prove:  !ctx.TrustNode,
@@ -159,25 +263,25 @@ func (ctx CLIContext) query(path string, key cmn.HexBytes) (res []byte, err erro
 
 	result, err := node.ABCIQueryWithOptions(path, key, opts)
 	if err != nil {
-		return res, err
+		return resp, err
 	}
 
-	resp := result.Response
+	resp = result.Response
 	if !resp.IsOK() {
-		return res, errors.New(resp.Log)
+		return resp, errors.New(resp.Log)
 	}
 
-	// data from trusted node or subspace query doesn't need verification
+	// data from trusted node or custom query doesn't need verification
 	if ctx.TrustNode || !isQueryStoreWithProof(path) {
-		return resp.Value, nil
+		return resp, nil
 	}
 
-	err = ctx.verifyProof(path, resp)
+	err = ctx.verifyProof(path, key, resp)
 	if err != nil {
-		return nil, err
+		return resp, err
 	}
 
-	return resp.Value, nil
+	return resp, nil
 }
 
 // Verify verifies the consensus proof at given height.
@@ -193,8 +297,8 @@ func (ctx CLIContext) Verify(height int64) (tmtypes.SignedHeader, error) {
 	return check, nil
 }
 
-// verifyProof perform response proof verification.
-func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) error {
+// verifyProof perform response proof verification against the queried key.
+func (ctx CLIContext) verifyProof(queryPath string, key []byte, resp abci.ResponseQuery) error {
 	if ctx.Verifier == nil {
 		return fmt.Errorf("missing valid certifier to verify data from distrusted node")
 	}
@@ -216,7 +320,7 @@ func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) err
 
 	kp := merkle.KeyPath{}
 	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
+	kp = kp.AppendKey(key, merkle.KeyEncodingURL)
 
 	if resp.Value == nil {
 		err = prt.VerifyAbsence(resp.Proof, commit.Header.AppHash, kp.String())
@@ -241,7 +345,8 @@ func (ctx CLIContext) queryStore(key cmn.HexBytes, storeName, endPath string) ([
 }
 
 // isQueryStoreWithProof expects a format like /<queryType>/<storeName>/<subpath>
-// queryType must be "store" and subpath must be "key" to require a proof.
+// queryType must be "store" and subpath must be "key" or "subspace" to require
+// a proof.
 func isQueryStoreWithProof(path string) bool {
 	if !strings.HasPrefix(path, "/") {
 		return false
@@ -260,7 +365,8 @@ func isQueryStoreWithProof(path string) bool {
 	return false
 }
 
-// parseQueryStorePath expects a format like /store/<storeName>/key.
+// parseQueryStorePath expects a format like /store/<storeName>/key or
+// /store/<storeName>/subspace.
 func parseQueryStorePath(path string) (storeName string, err error) {
 	if !strings.HasPrefix(path, "/") {
 		return "", errors.New("expected path to start with /")
@@ -272,7 +378,7 @@ func parseQueryStorePath(path string) (storeName string, err error) {
 		return "", errors.New("expected format like /store/<storeName>/key")
 	case paths[0] != "store":
 		return "", errors.New("expected format like /store/<storeName>/key")
-	case paths[2] != "key":
+	case !rootmulti.RequireProof("/" + paths[2]):
 		return "", errors.New("expected format like /store/<storeName>/key")
 	}
 
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -140,6 +141,14 @@ func TestCoinSend(t *testing.T) {
 	require.Equal(t, sdk.DefaultBondDenom, coins2[0].Denom)
 	require.Equal(t, int64(1), coins2[0].Amount.Int64())
 
+	// the receiver had no account before the transfer
+	res, body = Request(t, port, "GET", fmt.Sprintf("/auth/accounts/%s?height=%d", receiveAddr, resultTx.Height-1), nil)
+	require.Equal(t, http.StatusNoContent, res.StatusCode, body)
+	res, body = Request(t, port, "GET", fmt.Sprintf("/staking/pool?height=%d", resultTx.Height-1), nil)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	res, body = Request(t, port, "GET", "/staking/pool?height=-1", nil)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+
 	// test failure with too little gas
 	res, body, _ = doTransferWithGas(t, port, seed, name1, memo, pw, addr, "100", 0, false, true, fees)
 	require.Equal(t, http.StatusInternalServerError, res.StatusCode, body)
@@ -171,6 +180,8 @@ func TestCoinSend(t *testing.T) {
 	var gasEstResp rest.GasEstimateResponse
 	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &gasEstResp))
 	require.NotZero(t, gasEstResp.GasEstimate)
+	require.Equal(t, gasEstResp.GasEstimate, gasEstResp.GasTrace.Total())
+	require.NotZero(t, gasEstResp.GasTrace.MsgGas(0))
 
 	acc = getAccount(t, port, addr)
 	require.Equal(t, expectedBalance.Amount, acc.GetCoins().AmountOf(sdk.DefaultBondDenom))
@@ -368,6 +379,73 @@ func TestTxs(t *testing.T) {
 	require.Equal(t, http.StatusInternalServerError, res.StatusCode)
 }
 
+func TestSubscribeTxs(t *testing.T) {
+	kb, err := keys.NewKeyBaseFromDir(InitClientHome(t, ""))
+	require.NoError(t, err)
+	addr, seed := CreateAddr(t, name1, pw, kb)
+
+	viper.Set(client.FlagMaxWSConnections, 1)
+	viper.Set(client.FlagMaxWSSubscriptions, 2)
+	defer viper.Set(client.FlagMaxWSConnections, 0)
+	defer viper.Set(client.FlagMaxWSSubscriptions, 0)
+
+	cleanup, _, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr}, true)
+	defer cleanup()
+
+	conn := subscribeTxs(t, port)
+	defer conn.Close()
+
+	// the connection limit is reached
+	res, body := Request(t, port, "GET", "/txs/subscribe", nil)
+	require.Equal(t, http.StatusServiceUnavailable, res.StatusCode, body)
+
+	senderQuery := fmt.Sprintf("sender='%s'", addr)
+	sub := doTxSubscriptionRequest(t, conn, clienttx.ActionSubscribe, senderQuery)
+	require.Equal(t, clienttx.ActionSubscribe, sub.Action)
+	require.Equal(t, senderQuery, sub.Query)
+	require.Empty(t, sub.Error)
+
+	sub = doTxSubscriptionRequest(t, conn, clienttx.ActionSubscribe, senderQuery)
+	require.Equal(t, "already subscribed", sub.Error)
+
+	sub = doTxSubscriptionRequest(t, conn, clienttx.ActionSubscribe, "sender=")
+	require.NotEmpty(t, sub.Error)
+
+	sub = doTxSubscriptionRequest(t, conn, clienttx.ActionSubscribe, "action='send'")
+	require.Empty(t, sub.Error)
+
+	// the subscription limit is reached
+	sub = doTxSubscriptionRequest(t, conn, clienttx.ActionSubscribe, "action='vote'")
+	require.Contains(t, sub.Error, "too many subscriptions")
+
+	sub = doTxSubscriptionRequest(t, conn, clienttx.ActionUnsubscribe, "action='send'")
+	require.Empty(t, sub.Error)
+
+	sub = doTxSubscriptionRequest(t, conn, clienttx.ActionUnsubscribe, "action='send'")
+	require.Equal(t, "not subscribed", sub.Error)
+
+	sub = doTxSubscriptionRequest(t, conn, "resubscribe", senderQuery)
+	require.Contains(t, sub.Error, "unknown action")
+
+	// the transaction is pushed once, for the remaining subscription
+	_, resultTx := doTransfer(t, port, seed, name1, memo, pw, addr, fees)
+	sub = readTxSubscriptionResponse(t, conn)
+	require.Equal(t, clienttx.ActionTx, sub.Action)
+	require.Equal(t, senderQuery, sub.Query)
+	require.Equal(t, resultTx.TxHash, sub.Tx.TxHash)
+	require.Equal(t, resultTx.Height, sub.Tx.Height)
+	require.Len(t, sub.Tx.Tx.GetMsgs(), 1)
+
+	sub = doTxSubscriptionRequest(t, conn, clienttx.ActionUnsubscribeAll, "")
+	require.Empty(t, sub.Error)
+
+	// no transaction is pushed once unsubscribed
+	_, resultTx = doTransfer(t, port, seed, name1, memo, pw, addr, fees)
+	tests.WaitForHeight(resultTx.Height+1, port)
+	sub = doTxSubscriptionRequest(t, conn, clienttx.ActionUnsubscribe, senderQuery)
+	require.Equal(t, "not subscribed", sub.Error)
+}
+
 func TestPoolParamsQuery(t *testing.T) {
 	kb, err := keys.NewKeyBaseFromDir(InitClientHome(t, ""))
 	require.NoError(t, err)
@@ -15,9 +15,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gorilla/websocket"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/utils"
@@ -30,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -42,10 +45,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	gcutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	ibcrest "github.com/cosmos/cosmos-sdk/x/ibc/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingrest "github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingrest "github.com/cosmos/cosmos-sdk/x/staking/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyrest "github.com/cosmos/cosmos-sdk/x/supply/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgraderest "github.com/cosmos/cosmos-sdk/x/upgrade/client/rest"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmcfg "github.com/tendermint/tendermint/config"
@@ -220,7 +228,7 @@ func InitializeTestLCD(t *testing.T, nValidators int, initAddrs []sdk.AccAddress
 	privVal.Reset()
 
 	db := dbm.NewMemDB()
-	app := gapp.NewGaiaApp(logger, db, nil, true)
+	app := gapp.NewGaiaApp(logger, db, nil, true, baseapp.SetPruning(store.PruneSyncable))
 	cdc = gapp.MakeCodec()
 
 	genesisFile := config.GenesisFile()
@@ -407,7 +415,14 @@ func registerRoutes(rs *RestServer) {
 	distrrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, distr.StoreKey)
 	stakingrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	slashingrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
-	govrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	govrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc,
+		upgraderest.ProposalRESTHandler(rs.CliCtx, rs.Cdc),
+		distrrest.ProposalRESTHandler(rs.CliCtx, rs.Cdc),
+		ibcrest.ProposalRESTHandler(rs.CliCtx, rs.Cdc),
+	)
+	upgraderest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, upgrade.QuerierRoute)
+	supplyrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, supply.QuerierRoute)
+	ibcrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 }
 
 // Request makes a test LCD test request. It returns a response object and a
@@ -544,6 +559,29 @@ func getTransactions(t *testing.T, port string, tags ...string) []sdk.TxResponse
 	return txs
 }
 
+// GET /txs/subscribe subscribe to transactions over a websocket
+func subscribeTxs(t *testing.T, port string) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://localhost:%s/txs/subscribe", port), nil)
+	require.NoError(t, err)
+	return conn
+}
+
+func doTxSubscriptionRequest(t *testing.T, conn *websocket.Conn, action, query string) tx.TxSubscriptionResponse {
+	err := conn.WriteJSON(tx.TxSubscriptionRequest{Action: action, Query: query})
+	require.NoError(t, err)
+	return readTxSubscriptionResponse(t, conn)
+}
+
+func readTxSubscriptionResponse(t *testing.T, conn *websocket.Conn) tx.TxSubscriptionResponse {
+	_, msg, err := conn.ReadMessage()
+	require.NoError(t, err)
+
+	var res tx.TxSubscriptionResponse
+	err = cdc.UnmarshalJSON(msg, &res)
+	require.NoError(t, err)
+	return res
+}
+
 // ----------------------------------------------------------------------
 // ICS 1 - Keys
 // ----------------------------------------------------------------------
@@ -42,14 +42,15 @@ func WriteGenerateStdTxResponse(w http.ResponseWriter, cdc *codec.Codec,
 			return
 		}
 
-		txBldr, err = utils.EnrichWithGas(txBldr, cliCtx, msgs)
+		var gasTrace sdk.GasTrace
+		txBldr, gasTrace, err = utils.EnrichWithGasTrace(txBldr, cliCtx, msgs)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
 		if br.Simulate {
-			rest.WriteSimulationResponse(w, cdc, txBldr.Gas())
+			rest.WriteSimulationResponse(w, cdc, txBldr.Gas(), gasTrace)
 			return
 		}
 	}
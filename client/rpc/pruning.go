@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// PruningStatusCommand returns the pruning options and progress of the
+// connected node
+func PruningStatusCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pruning",
+		Short: "Query the pruning options and progress of the connected node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			status, err := getPruningStatus(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(status)
+		},
+	}
+
+	cmd.Flags().StringP(client.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(client.FlagNode, cmd.Flags().Lookup(client.FlagNode))
+	cmd.Flags().Bool(client.FlagIndentResponse, false, "indent JSON response")
+	viper.BindPFlag(client.FlagIndentResponse, cmd.Flags().Lookup(client.FlagIndentResponse))
+
+	return cmd
+}
+
+func getPruningStatus(cliCtx context.CLIContext) (status sdk.PruningStatus, err error) {
+	res, err := cliCtx.Query("/app/pruning", nil)
+	if err != nil {
+		return status, err
+	}
+
+	err = cliCtx.Codec.UnmarshalJSON(res, &status)
+	return status, err
+}
+
+// REST handler for the pruning options and progress of the connected node
+func NodePruningRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status, err := getPruningStatus(cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx.Codec, status, cliCtx.Indent)
+	}
+}
@@ -2,13 +2,18 @@ package tx
 
 import (
 	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // register REST routes
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	// registered before /txs/{hash}, which would match it otherwise
+	r.Handle("/txs/subscribe", NewTxSubscriptionHandler(cliCtx, cdc,
+		viper.GetInt(client.FlagMaxWSConnections), viper.GetInt(client.FlagMaxWSSubscriptions))).Methods("GET")
 	r.HandleFunc("/txs/{hash}", QueryTxRequestHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/txs", SearchTxRequestHandlerFn(cliCtx, cdc)).Methods("GET")
 	r.HandleFunc("/txs", BroadcastTxRequest(cliCtx, cdc)).Methods("POST")
@@ -0,0 +1,362 @@
+package tx
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tendermint/tendermint/libs/pubsub"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/lib/client"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// Actions of the messages exchanged over the transaction subscription
+// endpoint.
+const (
+	ActionSubscribe      = "subscribe"
+	ActionUnsubscribe    = "unsubscribe"
+	ActionUnsubscribeAll = "unsubscribe_all"
+	ActionTx             = "tx"
+	ActionError          = "error"
+)
+
+const (
+	wsWriteWait  = 10 * time.Second
+	wsPongWait   = 30 * time.Second
+	wsPingPeriod = (wsPongWait * 9) / 10
+	wsReadLimit  = 4096
+)
+
+// TxSubscriptionRequest is a message sent by a client of the transaction
+// subscription endpoint to subscribe to, or unsubscribe from, the transactions
+// matching a tag query such as "recipient='cosmos1...'".
+type TxSubscriptionRequest struct {
+	Action string `json:"action"`
+	Query  string `json:"query"`
+}
+
+// TxSubscriptionResponse is a message pushed to a client of the transaction
+// subscription endpoint. It either confirms a request, reports an error or
+// carries a transaction matching one of the queries of the client.
+type TxSubscriptionResponse struct {
+	Action string          `json:"action"`
+	Query  string          `json:"query,omitempty"`
+	Tx     *sdk.TxResponse `json:"tx,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// TxSubscriptionHandler serves the websocket endpoint through which clients
+// subscribe to the transactions matching tag queries. Each connection proxies
+// its subscriptions through its own websocket connection to the node.
+type TxSubscriptionHandler struct {
+	cliCtx   context.CLIContext
+	cdc      *codec.Codec
+	eventCdc *codec.Codec
+	upgrader websocket.Upgrader
+
+	maxConns int
+	maxSubs  int
+
+	mtx   sync.Mutex
+	conns int
+}
+
+// NewTxSubscriptionHandler returns a handler accepting up to maxConns
+// connections, each with up to maxSubs subscriptions. Zero means no limit.
+func NewTxSubscriptionHandler(cliCtx context.CLIContext, cdc *codec.Codec,
+	maxConns, maxSubs int) *TxSubscriptionHandler {
+
+	eventCdc := codec.New()
+	ctypes.RegisterAmino(eventCdc)
+
+	return &TxSubscriptionHandler{
+		cliCtx:   cliCtx,
+		cdc:      cdc,
+		eventCdc: eventCdc,
+		upgrader: websocket.Upgrader{
+			// the endpoint only serves public chain data
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		maxConns: maxConns,
+		maxSubs:  maxSubs,
+	}
+}
+
+// ServeHTTP upgrades the request to a websocket connection and serves the
+// subscription requests of the client until it disconnects.
+func (h *TxSubscriptionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.acquire() {
+		rest.WriteErrorResponse(w, http.StatusServiceUnavailable, "too many websocket connections")
+		return
+	}
+	defer h.release()
+
+	if h.cliCtx.NodeURI == "" {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, "no node to subscribe to")
+		return
+	}
+
+	reconnected := make(chan struct{}, 1)
+	node := rpcclient.NewWSClient(h.cliCtx.NodeURI, "/websocket", rpcclient.OnReconnect(func() {
+		select {
+		case reconnected <- struct{}{}:
+		default:
+		}
+	}))
+	if err := node.Start(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer node.Stop() // nolint: errcheck
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied to the client
+		return
+	}
+	defer conn.Close()
+
+	s := &txSubscriber{
+		handler: h,
+		node:    node,
+		conn:    conn,
+		queries: make(map[string]string),
+	}
+	s.serve(reconnected)
+}
+
+func (h *TxSubscriptionHandler) acquire() bool {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.maxConns > 0 && h.conns >= h.maxConns {
+		return false
+	}
+	h.conns++
+	return true
+}
+
+func (h *TxSubscriptionHandler) release() {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.conns--
+}
+
+// txSubscriber serves a connection to the transaction subscription endpoint.
+// Only its serve loop writes to the connection.
+type txSubscriber struct {
+	handler *TxSubscriptionHandler
+	node    *rpcclient.WSClient
+	conn    *websocket.Conn
+
+	// node query -> query of the client
+	queries map[string]string
+}
+
+func (s *txSubscriber) serve(reconnected <-chan struct{}) {
+	done := make(chan struct{})
+	defer close(done)
+
+	s.conn.SetReadLimit(wsReadLimit)
+	s.conn.SetReadDeadline(time.Now().Add(wsPongWait)) // nolint: errcheck
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	msgs := make(chan []byte)
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			_, msg, err := s.conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			select {
+			case msgs <- msg:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(wsPingPeriod)
+	defer ping.Stop()
+
+	for {
+		var err error
+		select {
+		case msg := <-msgs:
+			err = s.write(s.handle(msg))
+
+		case res, ok := <-s.node.ResponsesCh:
+			if !ok {
+				return
+			}
+			err = s.forward(res)
+
+		case <-reconnected:
+			for q := range s.queries {
+				if err = s.subscribe(q); err != nil {
+					break
+				}
+			}
+
+		case <-ping.C:
+			err = s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait))
+
+		case <-closed:
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// handle processes a request of the client and returns the response to it.
+func (s *txSubscriber) handle(msg []byte) TxSubscriptionResponse {
+	var req TxSubscriptionRequest
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return TxSubscriptionResponse{Action: ActionError, Error: err.Error()}
+	}
+
+	res := TxSubscriptionResponse{Action: req.Action, Query: req.Query}
+	var err error
+	switch req.Action {
+	case ActionSubscribe:
+		err = s.handleSubscribe(req.Query)
+	case ActionUnsubscribe:
+		err = s.handleUnsubscribe(req.Query)
+	case ActionUnsubscribeAll:
+		err = s.handleUnsubscribeAll()
+	default:
+		err = fmt.Errorf("unknown action %q", req.Action)
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res
+}
+
+func (s *txSubscriber) handleSubscribe(q string) error {
+	nodeQuery := txQuery(q)
+	if _, ok := s.queries[nodeQuery]; ok {
+		return errors.New("already subscribed")
+	}
+	if maxSubs := s.handler.maxSubs; maxSubs > 0 && len(s.queries) >= maxSubs {
+		return fmt.Errorf("too many subscriptions, at most %d per connection", maxSubs)
+	}
+	if _, err := query.New(nodeQuery); err != nil {
+		return err
+	}
+
+	if err := s.subscribe(nodeQuery); err != nil {
+		return err
+	}
+	s.queries[nodeQuery] = q
+	return nil
+}
+
+func (s *txSubscriber) handleUnsubscribe(q string) error {
+	nodeQuery := txQuery(q)
+	if _, ok := s.queries[nodeQuery]; !ok {
+		return errors.New("not subscribed")
+	}
+
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), wsWriteWait)
+	defer cancel()
+	if err := s.node.Unsubscribe(ctx, nodeQuery); err != nil {
+		return err
+	}
+	delete(s.queries, nodeQuery)
+	return nil
+}
+
+func (s *txSubscriber) handleUnsubscribeAll() error {
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), wsWriteWait)
+	defer cancel()
+	if err := s.node.UnsubscribeAll(ctx); err != nil {
+		return err
+	}
+	s.queries = make(map[string]string)
+	return nil
+}
+
+func (s *txSubscriber) subscribe(nodeQuery string) error {
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), wsWriteWait)
+	defer cancel()
+	return s.node.Subscribe(ctx, nodeQuery)
+}
+
+// forward pushes the transaction of an event of the node to the client. The
+// confirmations of the (un)subscriptions by the node carry no event, and the
+// node reports the subscriptions the client cancelled as errors.
+func (s *txSubscriber) forward(res rpctypes.RPCResponse) error {
+	if res.Error != nil {
+		if strings.Contains(res.Error.Data, pubsub.ErrUnsubscribed.Error()) {
+			return nil
+		}
+		return s.write(TxSubscriptionResponse{Action: ActionError, Error: res.Error.Error()})
+	}
+
+	var event ctypes.ResultEvent
+	if err := s.handler.eventCdc.UnmarshalJSON(res.Result, &event); err != nil {
+		return s.write(TxSubscriptionResponse{Action: ActionError, Error: err.Error()})
+	}
+	q, ok := s.queries[event.Query]
+	if !ok {
+		return nil
+	}
+	data, ok := event.Data.(tmtypes.EventDataTx)
+	if !ok {
+		return nil
+	}
+
+	tx, err := formatTxResult(s.handler.cdc, &ctypes.ResultTx{
+		Hash:     data.Tx.Hash(),
+		Height:   data.Height,
+		Index:    data.Index,
+		TxResult: data.Result,
+		Tx:       data.Tx,
+	})
+	if err != nil {
+		return s.write(TxSubscriptionResponse{Action: ActionError, Query: q, Error: err.Error()})
+	}
+	return s.write(TxSubscriptionResponse{Action: ActionTx, Query: q, Tx: &tx})
+}
+
+func (s *txSubscriber) write(res TxSubscriptionResponse) error {
+	bz, err := s.handler.cdc.MarshalJSON(res)
+	if err != nil {
+		return err
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(wsWriteWait)); err != nil {
+		return err
+	}
+	return s.conn.WriteMessage(websocket.TextMessage, bz)
+}
+
+// txQuery returns the query of the node matching the transactions matching the
+// query of a client, or all transactions if it is empty.
+func txQuery(q string) string {
+	if q == "" {
+		return tmtypes.EventQueryTx.String()
+	}
+	return fmt.Sprintf("%s AND %s", tmtypes.EventQueryTx, q)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/viper"
 	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,11 +23,22 @@ import (
 
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
-	GasEstimate uint64 `json:"gas_estimate"`
+	GasEstimate uint64       `json:"gas_estimate"`
+	GasTrace    sdk.GasTrace `json:"gas_trace,omitempty"`
 }
 
 func (gr GasEstimateResponse) String() string {
-	return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("gas estimate: %d", gr.GasEstimate))
+
+	for _, e := range gr.GasTrace {
+		if e.MsgIndex < 0 {
+			sb.WriteString(fmt.Sprintf("\n  tx: %s: %d", e.Descriptor, e.Gas))
+		} else {
+			sb.WriteString(fmt.Sprintf("\n  msg %d: %s: %d", e.MsgIndex, e.Descriptor, e.Gas))
+		}
+	}
+	return sb.String()
 }
 
 // GenerateOrBroadcastMsgs respects CLI flags and outputs a message
@@ -51,12 +63,16 @@ func CompleteAndBroadcastTxCLI(txBldr authtxb.TxBuilder, cliCtx context.CLIConte
 	fromName := cliCtx.GetFromName()
 
 	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
-		txBldr, err = EnrichWithGas(txBldr, cliCtx, msgs)
+		var gasTrace sdk.GasTrace
+		txBldr, gasTrace, err = EnrichWithGasTrace(txBldr, cliCtx, msgs)
 		if err != nil {
 			return err
 		}
 
 		gasEst := GasEstimateResponse{GasEstimate: txBldr.Gas()}
+		if cliCtx.Simulate {
+			gasEst.GasTrace = gasTrace
+		}
 		fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
 	}
 
@@ -109,27 +125,37 @@ func CompleteAndBroadcastTxCLI(txBldr authtxb.TxBuilder, cliCtx context.CLIConte
 // EnrichWithGas calculates the gas estimate that would be consumed by the
 // transaction and set the transaction's respective value accordingly.
 func EnrichWithGas(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (authtxb.TxBuilder, error) {
-	_, adjusted, err := simulateMsgs(txBldr, cliCtx, msgs)
+	txBldr, _, err := EnrichWithGasTrace(txBldr, cliCtx, msgs)
+	return txBldr, err
+}
+
+// EnrichWithGasTrace sets the transaction's gas like EnrichWithGas, and also
+// returns the itemized gas consumed by the simulated transaction.
+func EnrichWithGasTrace(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (authtxb.TxBuilder, sdk.GasTrace, error) {
+	_, adjusted, gasTrace, err := simulateMsgs(txBldr, cliCtx, msgs)
 	if err != nil {
-		return txBldr, err
+		return txBldr, nil, err
 	}
-	return txBldr.WithGas(adjusted), nil
+	return txBldr.WithGas(adjusted), gasTrace, nil
 }
 
 // CalculateGas simulates the execution of a transaction and returns
-// both the estimate obtained by the query and the adjusted amount.
-func CalculateGas(queryFunc func(string, common.HexBytes) ([]byte, error), cdc *amino.Codec, txBytes []byte, adjustment float64) (estimate, adjusted uint64, err error) {
+// both the estimate obtained by the query and the adjusted amount, along
+// with the itemized gas consumed by the transaction.
+func CalculateGas(queryFunc func(string, common.HexBytes) ([]byte, error), cdc *amino.Codec, txBytes []byte, adjustment float64) (estimate, adjusted uint64, gasTrace sdk.GasTrace, err error) {
 	// run a simulation (via /app/simulate query) to
 	// estimate gas and update TxBuilder accordingly
 	rawRes, err := queryFunc("/app/simulate", txBytes)
 	if err != nil {
 		return
 	}
-	estimate, err = parseQueryResponse(cdc, rawRes)
+	simRes, err := parseQueryResponse(cdc, rawRes)
 	if err != nil {
 		return
 	}
+	estimate = simRes.Result.GasUsed
 	adjusted = adjustGasEstimate(estimate, adjustment)
+	gasTrace = simRes.GasTrace
 	return
 }
 
@@ -271,13 +297,14 @@ func GetTxEncoder(cdc *codec.Codec) (encoder sdk.TxEncoder) {
 }
 
 // nolint
-// SimulateMsgs simulates the transaction and returns the gas estimate and the adjusted value.
-func simulateMsgs(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (estimated, adjusted uint64, err error) {
+// SimulateMsgs simulates the transaction and returns the gas estimate, the adjusted value
+// and the gas trace.
+func simulateMsgs(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (estimated, adjusted uint64, gasTrace sdk.GasTrace, err error) {
 	txBytes, err := txBldr.BuildTxForSim(msgs)
 	if err != nil {
 		return
 	}
-	estimated, adjusted, err = CalculateGas(cliCtx.Query, cliCtx.Codec, txBytes, txBldr.GasAdjustment())
+	estimated, adjusted, gasTrace, err = CalculateGas(cliCtx.Query, cliCtx.Codec, txBytes, txBldr.GasAdjustment())
 	return
 }
 
@@ -285,12 +312,12 @@ func adjustGasEstimate(estimate uint64, adjustment float64) uint64 {
 	return uint64(adjustment * float64(estimate))
 }
 
-func parseQueryResponse(cdc *amino.Codec, rawRes []byte) (uint64, error) {
-	var simulationResult sdk.Result
-	if err := cdc.UnmarshalBinaryLengthPrefixed(rawRes, &simulationResult); err != nil {
-		return 0, err
+func parseQueryResponse(cdc *amino.Codec, rawRes []byte) (sdk.SimulationResponse, error) {
+	var simRes sdk.SimulationResponse
+	if err := cdc.UnmarshalBinaryLengthPrefixed(rawRes, &simRes); err != nil {
+		return sdk.SimulationResponse{}, err
 	}
-	return simulationResult.GasUsed, nil
+	return simRes, nil
 }
 
 // PrepareTxBuilder populates a TxBuilder in preparation for the build of a Tx.
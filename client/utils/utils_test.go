@@ -25,12 +25,17 @@ var (
 
 func TestParseQueryResponse(t *testing.T) {
 	cdc := app.MakeCodec()
-	sdkResBytes := cdc.MustMarshalBinaryLengthPrefixed(sdk.Result{GasUsed: 10})
-	gas, err := parseQueryResponse(cdc, sdkResBytes)
-	assert.Equal(t, gas, uint64(10))
+	gasTrace := sdk.GasTrace{{MsgIndex: 0, Descriptor: "WriteFlat", Gas: 10}}
+	simResBytes := cdc.MustMarshalBinaryLengthPrefixed(sdk.SimulationResponse{
+		Result:   sdk.Result{GasUsed: 10},
+		GasTrace: gasTrace,
+	})
+	simRes, err := parseQueryResponse(cdc, simResBytes)
+	assert.Equal(t, simRes.Result.GasUsed, uint64(10))
+	assert.Equal(t, simRes.GasTrace, gasTrace)
 	assert.Nil(t, err)
-	gas, err = parseQueryResponse(cdc, []byte("fuzzy"))
-	assert.Equal(t, gas, uint64(0))
+	simRes, err = parseQueryResponse(cdc, []byte("fuzzy"))
+	assert.Equal(t, simRes.Result.GasUsed, uint64(0))
 	assert.NotNil(t, err)
 }
 
@@ -41,7 +46,7 @@ func TestCalculateGas(t *testing.T) {
 			if wantErr {
 				return nil, errors.New("")
 			}
-			return cdc.MustMarshalBinaryLengthPrefixed(sdk.Result{GasUsed: gasUsed}), nil
+			return cdc.MustMarshalBinaryLengthPrefixed(sdk.SimulationResponse{Result: sdk.Result{GasUsed: gasUsed}}), nil
 		}
 	}
 	type args struct {
@@ -62,7 +67,7 @@ func TestCalculateGas(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			queryFunc := makeQueryFunc(tt.args.queryFuncGasUsed, tt.args.queryFuncWantErr)
-			gotEstimate, gotAdjusted, err := CalculateGas(queryFunc, cdc, []byte(""), tt.args.adjustment)
+			gotEstimate, gotAdjusted, _, err := CalculateGas(queryFunc, cdc, []byte(""), tt.args.adjustment)
 			assert.Equal(t, err != nil, tt.wantErr)
 			assert.Equal(t, gotEstimate, tt.wantEstimate)
 			assert.Equal(t, gotAdjusted, tt.wantAdjusted)
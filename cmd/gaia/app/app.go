@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -11,20 +12,21 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 
-	// TODO: Remove once transfers are enabled.
-	gaiabank "github.com/cosmos/cosmos-sdk/cmd/gaia/app/x/bank"
-
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 const (
@@ -39,6 +41,15 @@ var (
 	DefaultNodeHome = os.ExpandEnv("$HOME/.gaiad")
 )
 
+// permissions of the module accounts
+var maccPerms = map[string][]string{
+	auth.FeeCollectorName: nil,
+	distr.ModuleName:      nil,
+	mint.ModuleName:       {auth.Minter},
+	staking.ModuleName:    {auth.Burner, auth.Staking},
+	ibc.ModuleName:        {auth.Minter, auth.Burner},
+}
+
 // Extended ABCI application
 type GaiaApp struct {
 	*bam.BaseApp
@@ -54,7 +65,10 @@ type GaiaApp struct {
 	keyDistr         *sdk.KVStoreKey
 	tkeyDistr        *sdk.TransientStoreKey
 	keyGov           *sdk.KVStoreKey
+	keyUpgrade       *sdk.KVStoreKey
+	keyIBC           *sdk.KVStoreKey
 	keyFeeCollection *sdk.KVStoreKey
+	keySupply        *sdk.KVStoreKey
 	keyParams        *sdk.KVStoreKey
 	tkeyParams       *sdk.TransientStoreKey
 
@@ -62,12 +76,21 @@ type GaiaApp struct {
 	accountKeeper       auth.AccountKeeper
 	feeCollectionKeeper auth.FeeCollectionKeeper
 	bankKeeper          bank.Keeper
+	supplyKeeper        supply.Keeper
 	stakingKeeper       staking.Keeper
 	slashingKeeper      slashing.Keeper
 	mintKeeper          mint.Keeper
 	distrKeeper         distr.Keeper
 	govKeeper           gov.Keeper
+	upgradeKeeper       upgrade.Keeper
+	ibcMapper           ibc.Mapper
 	paramsKeeper        params.Keeper
+
+	// the module manager
+	mm *module.Manager
+
+	// invariants registered by the modules, asserted at the end of each block
+	invarRouter invariantRouter
 }
 
 // NewGaiaApp returns a reference to an initialized GaiaApp.
@@ -89,7 +112,10 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		tkeyDistr:        sdk.NewTransientStoreKey(distr.TStoreKey),
 		keySlashing:      sdk.NewKVStoreKey(slashing.StoreKey),
 		keyGov:           sdk.NewKVStoreKey(gov.StoreKey),
+		keyUpgrade:       sdk.NewKVStoreKey(upgrade.StoreKey),
+		keyIBC:           sdk.NewKVStoreKey(ibc.StoreKey),
 		keyFeeCollection: sdk.NewKVStoreKey(auth.FeeStoreKey),
+		keySupply:        sdk.NewKVStoreKey(supply.StoreKey),
 		keyParams:        sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:       sdk.NewTransientStoreKey(params.TStoreKey),
 	}
@@ -105,10 +131,13 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	)
 
 	// add handlers
+	app.supplyKeeper = supply.NewKeeper(app.cdc, app.keySupply)
 	app.bankKeeper = bank.NewBaseKeeper(
 		app.accountKeeper,
+		app.supplyKeeper,
 		app.paramsKeeper.Subspace(bank.DefaultParamspace),
 		bank.DefaultCodespace,
+		maccPerms,
 	)
 	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(
 		app.cdc,
@@ -122,7 +151,7 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	)
 	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint,
 		app.paramsKeeper.Subspace(mint.DefaultParamspace),
-		&stakingKeeper, app.feeCollectionKeeper,
+		&stakingKeeper, app.supplyKeeper, app.bankKeeper, app.feeCollectionKeeper,
 	)
 	app.distrKeeper = distr.NewKeeper(
 		app.cdc,
@@ -137,13 +166,34 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		&stakingKeeper, app.paramsKeeper.Subspace(slashing.DefaultParamspace),
 		slashing.DefaultCodespace,
 	)
+	app.upgradeKeeper = upgrade.NewKeeper(
+		app.cdc,
+		app.keyUpgrade,
+		upgrade.DefaultCodespace,
+	)
+	app.ibcMapper = ibc.NewMapper(
+		app.cdc,
+		app.keyIBC,
+		ibc.DefaultCodespace,
+	)
+
+	// register the proposal types
+	govRouter := gov.NewRouter()
+	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, gov.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(ibc.RouterKey, ibc.NewCreateClientProposalHandler(app.ibcMapper))
 	app.govKeeper = gov.NewKeeper(
 		app.cdc,
 		app.keyGov,
 		app.paramsKeeper, app.paramsKeeper.Subspace(gov.DefaultParamspace), app.bankKeeper, &stakingKeeper,
-		gov.DefaultCodespace,
+		gov.DefaultCodespace, govRouter,
 	)
 
+	// register the handlers of the upgrades this binary implements, e.g.:
+	// app.upgradeKeeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan upgrade.Plan) { ... })
+
 	// register the staking hooks
 	// NOTE: The stakingKeeper above is passed by reference, so that it can be
 	// modified like below:
@@ -151,31 +201,47 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		NewStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
 	)
 
-	// register message routes
-	//
-	// TODO: Use standard bank router once transfers are enabled.
-	app.Router().
-		AddRoute(bank.RouterKey, gaiabank.NewHandler(app.bankKeeper)).
-		AddRoute(staking.RouterKey, staking.NewHandler(app.stakingKeeper)).
-		AddRoute(distr.RouterKey, distr.NewHandler(app.distrKeeper)).
-		AddRoute(slashing.RouterKey, slashing.NewHandler(app.slashingKeeper)).
-		AddRoute(gov.RouterKey, gov.NewHandler(app.govKeeper))
-
-	app.QueryRouter().
-		AddRoute(auth.QuerierRoute, auth.NewQuerier(app.accountKeeper)).
-		AddRoute(distr.QuerierRoute, distr.NewQuerier(app.distrKeeper)).
-		AddRoute(gov.QuerierRoute, gov.NewQuerier(app.govKeeper)).
-		AddRoute(slashing.QuerierRoute, slashing.NewQuerier(app.slashingKeeper, app.cdc)).
-		AddRoute(staking.QuerierRoute, staking.NewQuerier(app.stakingKeeper, app.cdc))
+	app.mm = module.NewManager(
+		auth.NewAppModule(app.accountKeeper, app.feeCollectionKeeper),
+		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
+		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
+		distr.NewAppModule(app.distrKeeper),
+		gov.NewAppModule(app.govKeeper),
+		mint.NewAppModule(app.mintKeeper),
+		slashing.NewAppModule(app.slashingKeeper),
+		staking.NewAppModule(app.stakingKeeper, app.accountKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
+		ibc.NewAppModule(app.ibcMapper, app.bankKeeper),
+	)
+
+	// During begin block slashing happens after distr.BeginBlocker so that
+	// there is nothing left over in the validator fee pool, so as to keep the
+	// CanWithdrawInvariant invariant. Upgrades are applied before any other
+	// module runs. The modules listed last have no begin or end blockers.
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName,
+		auth.ModuleName, bank.ModuleName, supply.ModuleName, gov.ModuleName, staking.ModuleName, ibc.ModuleName)
+	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName,
+		auth.ModuleName, bank.ModuleName, supply.ModuleName, distr.ModuleName, mint.ModuleName,
+		slashing.ModuleName, upgrade.ModuleName, ibc.ModuleName)
+
+	// genesis accounts are initialized by the app itself; supply must be
+	// initialized before any module moves coins, distribution before staking,
+	// and staking before slashing
+	app.mm.SetOrderInitGenesis(supply.ModuleName, distr.ModuleName, staking.ModuleName, auth.ModuleName,
+		bank.ModuleName, slashing.ModuleName, gov.ModuleName, mint.ModuleName, upgrade.ModuleName,
+		ibc.ModuleName)
+
+	app.mm.RegisterInvariants(&app.invarRouter)
+	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
 	// initialize BaseApp
 	app.MountStores(app.keyMain, app.keyAccount, app.keyStaking, app.keyMint, app.keyDistr,
-		app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams,
+		app.keySlashing, app.keyGov, app.keyUpgrade, app.keyIBC, app.keyFeeCollection, app.keySupply, app.keyParams,
 		app.tkeyParams, app.tkeyStaking, app.tkeyDistr,
 	)
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
+	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper, app.supplyKeeper))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -196,49 +262,32 @@ func MakeCodec() *codec.Codec {
 	distr.RegisterCodec(cdc)
 	slashing.RegisterCodec(cdc)
 	gov.RegisterCodec(cdc)
+	upgrade.RegisterCodec(cdc)
+	ibc.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
 }
 
-// application updates every end block
+// application updates every begin block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	// mint new tokens for the previous block
-	mint.BeginBlocker(ctx, app.mintKeeper)
-
-	// distribute rewards for the previous block
-	distr.BeginBlocker(ctx, req, app.distrKeeper)
-
-	// slash anyone who double signed.
-	// NOTE: This should happen after distr.BeginBlocker so that
-	// there is nothing left over in the validator fee pool,
-	// so as to keep the CanWithdrawInvariant invariant.
-	// TODO: This should really happen at EndBlocker.
-	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
-
-	return abci.ResponseBeginBlock{
-		Tags: tags.ToKVPairs(),
-	}
+	return app.mm.BeginBlock(ctx, req)
 }
 
 // application updates every end block
-// nolint: unparam
 func (app *GaiaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	tags := gov.EndBlocker(ctx, app.govKeeper)
-	validatorUpdates, endBlockerTags := staking.EndBlocker(ctx, app.stakingKeeper)
-	tags = append(tags, endBlockerTags...)
+	res := app.mm.EndBlock(ctx, req)
 
 	app.assertRuntimeInvariants()
 
-	return abci.ResponseEndBlock{
-		ValidatorUpdates: validatorUpdates,
-		Tags:             tags,
-	}
+	return res
 }
 
 // initialize store from a genesis state
-func (app *GaiaApp) initFromGenesisState(ctx sdk.Context, genesisState GenesisState) []abci.ValidatorUpdate {
+func (app *GaiaApp) initFromGenesisState(ctx sdk.Context, genesisState GenesisState,
+	moduleGenesis map[string]json.RawMessage) []abci.ValidatorUpdate {
+
 	genesisState.Sanitize()
 
 	// load the accounts
@@ -248,21 +297,8 @@ func (app *GaiaApp) initFromGenesisState(ctx sdk.Context, genesisState GenesisSt
 		app.accountKeeper.SetAccount(ctx, acc)
 	}
 
-	// initialize distribution (must happen before staking)
-	distr.InitGenesis(ctx, app.distrKeeper, genesisState.DistrData)
-
-	// load the initial staking information
-	validators, err := staking.InitGenesis(ctx, app.stakingKeeper, genesisState.StakingData)
-	if err != nil {
-		panic(err) // TODO find a way to do this w/o panics
-	}
-
 	// initialize module-specific stores
-	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthData)
-	bank.InitGenesis(ctx, app.bankKeeper, genesisState.BankData)
-	slashing.InitGenesis(ctx, app.slashingKeeper, genesisState.SlashingData, genesisState.StakingData.Validators.ToSDKValidators())
-	gov.InitGenesis(ctx, app.govKeeper, genesisState.GovData)
-	mint.InitGenesis(ctx, app.mintKeeper, genesisState.MintData)
+	validators := app.mm.InitGenesis(ctx, moduleGenesis).Validators
 
 	// validate genesis state
 	if err := GaiaValidateGenesisState(genesisState); err != nil {
@@ -272,7 +308,7 @@ func (app *GaiaApp) initFromGenesisState(ctx sdk.Context, genesisState GenesisSt
 	if len(genesisState.GenTxs) > 0 {
 		for _, genTx := range genesisState.GenTxs {
 			var tx auth.StdTx
-			err = app.cdc.UnmarshalJSON(genTx, &tx)
+			err := app.cdc.UnmarshalJSON(genTx, &tx)
 			if err != nil {
 				panic(err)
 			}
@@ -300,7 +336,13 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 		// return sdk.ErrGenesisParse("").TraceCause(err, "")
 	}
 
-	validators := app.initFromGenesisState(ctx, genesisState)
+	// the module genesis states are keyed by module name
+	var moduleGenesis map[string]json.RawMessage
+	if err := json.Unmarshal(stateJSON, &moduleGenesis); err != nil {
+		panic(err)
+	}
+
+	validators := app.initFromGenesisState(ctx, genesisState, moduleGenesis)
 
 	// sanity check
 	if len(req.Validators) > 0 {
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -31,6 +32,7 @@ func setGenesis(gapp *GaiaApp, accs ...*auth.BaseAccount) error {
 		genaccs,
 		auth.DefaultGenesisState(),
 		bank.DefaultGenesisState(),
+		supply.DefaultGenesisState(),
 		staking.DefaultGenesisState(),
 		mint.DefaultGenesisState(),
 		distr.DefaultGenesisState(),
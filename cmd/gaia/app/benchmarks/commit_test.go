@@ -0,0 +1,122 @@
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// commitBenchStore describes the size of a Gaia substore and the number of its
+// entries written in every block.
+type commitBenchStore struct {
+	name         string
+	entries      int
+	writesPerBlk int
+}
+
+// Substore sizes roughly matching a Gaia chain with 50k accounts and 100
+// validators, where a block moves funds, updates delegations and rewards and
+// records the validators' liveness.
+var commitBenchStores = []commitBenchStore{
+	{bam.MainStoreKey, 10, 1},
+	{auth.StoreKey, 50000, 200},
+	{auth.FeeStoreKey, 1, 1},
+	{staking.StoreKey, 20000, 50},
+	{mint.StoreKey, 1, 1},
+	{distr.StoreKey, 20000, 150},
+	{slashing.StoreKey, 15000, 100},
+	{gov.StoreKey, 1000, 5},
+	{upgrade.StoreKey, 10, 0},
+	{supply.StoreKey, 5, 2},
+	{params.StoreKey, 50, 0},
+}
+
+// BenchmarkCommit measures the latency of committing a block to a multistore
+// with Gaia's substores, committing the substores one after another and
+// concurrently:
+//
+// go test -run=^$ -bench=BenchmarkCommit -benchtime=200x ./cmd/gaia/app/benchmarks
+func BenchmarkCommit(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) { benchmarkCommit(b, false) })
+	b.Run("parallel", func(b *testing.B) { benchmarkCommit(b, true) })
+}
+
+func benchmarkCommit(b *testing.B, parallel bool) {
+	dir, err := ioutil.TempDir("", "commit-bench")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db := dbm.NewDB("application", dbm.GoLevelDBBackend, dir)
+	defer db.Close()
+
+	rs := rootmulti.NewStore(db)
+	rs.SetPruning(store.PruneSyncable)
+	rs.SetParallelCommit(parallel)
+
+	keys := make([]sdk.StoreKey, len(commitBenchStores))
+	for i, s := range commitBenchStores {
+		keys[i] = sdk.NewKVStoreKey(s.name)
+		rs.MountStoreWithDB(keys[i], sdk.StoreTypeIAVL, nil)
+	}
+	rs.MountStoreWithDB(sdk.NewTransientStoreKey(params.TStoreKey), sdk.StoreTypeTransient, nil)
+	if err := rs.LoadLatestVersion(); err != nil {
+		b.Fatal(err)
+	}
+
+	// set up the initial state
+	r := rand.New(rand.NewSource(1))
+	for i, s := range commitBenchStores {
+		kvStore := rs.GetKVStore(keys[i])
+		for j := 0; j < s.entries; j++ {
+			kvStore.Set(commitBenchKey(j), commitBenchValue(r))
+		}
+	}
+	rs.Commit()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		for i, s := range commitBenchStores {
+			kvStore := rs.GetKVStore(keys[i])
+			for j := 0; j < s.writesPerBlk; j++ {
+				kvStore.Set(commitBenchKey(r.Intn(s.entries)), commitBenchValue(r))
+			}
+		}
+		b.StartTimer()
+
+		rs.Commit()
+	}
+}
+
+func commitBenchKey(i int) []byte {
+	return []byte(fmt.Sprintf("key/%08d", i))
+}
+
+// values have the size of an encoded account or delegation
+func commitBenchValue(r *rand.Rand) []byte {
+	value := make([]byte, 150)
+	r.Read(value)
+	return value
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 var (
@@ -35,6 +36,7 @@ type GenesisState struct {
 	Accounts     []GenesisAccount      `json:"accounts"`
 	AuthData     auth.GenesisState     `json:"auth"`
 	BankData     bank.GenesisState     `json:"bank"`
+	SupplyData   supply.GenesisState   `json:"supply"`
 	StakingData  staking.GenesisState  `json:"staking"`
 	MintData     mint.GenesisState     `json:"mint"`
 	DistrData    distr.GenesisState    `json:"distr"`
@@ -44,7 +46,7 @@ type GenesisState struct {
 }
 
 func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState,
-	bankData bank.GenesisState,
+	bankData bank.GenesisState, supplyData supply.GenesisState,
 	stakingData staking.GenesisState, mintData mint.GenesisState,
 	distrData distr.GenesisState, govData gov.GenesisState,
 	slashingData slashing.GenesisState) GenesisState {
@@ -53,6 +55,7 @@ func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState,
 		Accounts:     accounts,
 		AuthData:     authData,
 		BankData:     bankData,
+		SupplyData:   supplyData,
 		StakingData:  stakingData,
 		MintData:     mintData,
 		DistrData:    distrData,
@@ -85,6 +88,13 @@ type GenesisAccount struct {
 	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // delegated vesting coins at time of delegation
 	StartTime        int64     `json:"start_time"`        // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time"`          // vesting end time (UNIX Epoch time)
+
+	// periodic vesting account fields
+	VestingPeriods auth.Periods `json:"vesting_periods"` // vesting periods, in order
+
+	// module account fields
+	ModuleName        string   `json:"module_name"`        // name of the module account
+	ModulePermissions []string `json:"module_permissions"` // permissions of the module account
 }
 
 func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
@@ -113,6 +123,17 @@ func NewGenesisAccountI(acc auth.Account) GenesisAccount {
 		gacc.EndTime = vacc.GetEndTime()
 	}
 
+	pvacc, ok := acc.(*auth.PeriodicVestingAccount)
+	if ok {
+		gacc.VestingPeriods = pvacc.GetVestingPeriods()
+	}
+
+	macc, ok := acc.(*auth.ModuleAccount)
+	if ok {
+		gacc.ModuleName = macc.GetName()
+		gacc.ModulePermissions = macc.GetPermissions()
+	}
+
 	return gacc
 }
 
@@ -134,7 +155,13 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 			EndTime:          ga.EndTime,
 		}
 
-		if ga.StartTime != 0 && ga.EndTime != 0 {
+		if len(ga.VestingPeriods) > 0 {
+			return &auth.PeriodicVestingAccount{
+				BaseVestingAccount: baseVestingAcc,
+				StartTime:          ga.StartTime,
+				VestingPeriods:     ga.VestingPeriods,
+			}
+		} else if ga.StartTime != 0 && ga.EndTime != 0 {
 			return &auth.ContinuousVestingAccount{
 				BaseVestingAccount: baseVestingAcc,
 				StartTime:          ga.StartTime,
@@ -148,6 +175,10 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 		}
 	}
 
+	if ga.ModuleName != "" {
+		return auth.NewModuleAccount(bacc, ga.ModuleName, ga.ModulePermissions...)
+	}
+
 	return bacc
 }
 
@@ -205,6 +236,7 @@ func NewDefaultGenesisState() GenesisState {
 		Accounts:     nil,
 		AuthData:     auth.DefaultGenesisState(),
 		BankData:     bank.DefaultGenesisState(),
+		SupplyData:   supply.DefaultGenesisState(),
 		StakingData:  staking.DefaultGenesisState(),
 		MintData:     mint.DefaultGenesisState(),
 		DistrData:    distr.DefaultGenesisState(),
@@ -234,6 +266,9 @@ func GaiaValidateGenesisState(genesisState GenesisState) error {
 	if err := bank.ValidateGenesis(genesisState.BankData); err != nil {
 		return err
 	}
+	if err := supply.ValidateGenesis(genesisState.SupplyData); err != nil {
+		return err
+	}
 	if err := staking.ValidateGenesis(genesisState.StakingData); err != nil {
 		return err
 	}
@@ -277,6 +312,27 @@ func validateGenesisStateAccounts(accs []GenesisAccount) error {
 					time.Unix(acc.EndTime, 0).UTC().Format(time.RFC3339),
 				)
 			}
+
+			if len(acc.VestingPeriods) > 0 {
+				if acc.StartTime+acc.VestingPeriods.TotalLength() != acc.EndTime {
+					return fmt.Errorf("vesting periods must end at the end time; address: %s", addrStr)
+				}
+
+				if err := acc.VestingPeriods.Validate(acc.OriginalVesting); err != nil {
+					return fmt.Errorf("%v; address: %s", err, addrStr)
+				}
+			}
+		}
+
+		// validate any module account fields
+		if acc.ModuleName != "" {
+			if !acc.Address.Equals(auth.NewModuleAddress(acc.ModuleName)) {
+				return fmt.Errorf("invalid address for module account %s; address: %s", acc.ModuleName, addrStr)
+			}
+
+			if err := auth.ValidatePermissions(acc.ModulePermissions...); err != nil {
+				return fmt.Errorf("%v; address: %s", err, addrStr)
+			}
 		}
 
 		addrMap[addrStr] = true
@@ -68,6 +68,17 @@ func TestToAccount(t *testing.T) {
 	acc = genAcc.ToAccount()
 	require.IsType(t, &auth.ContinuousVestingAccount{}, acc)
 	require.Equal(t, vacc, acc.(*auth.ContinuousVestingAccount))
+
+	pvacc := auth.NewPeriodicVestingAccount(
+		&authAcc, time.Now().Unix(), auth.Periods{
+			{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(defaultBondDenom, 50))},
+			{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(defaultBondDenom, 100))},
+		},
+	)
+	genAcc = NewGenesisAccountI(pvacc)
+	acc = genAcc.ToAccount()
+	require.IsType(t, &auth.PeriodicVestingAccount{}, acc)
+	require.Equal(t, pvacc, acc.(*auth.PeriodicVestingAccount))
 }
 
 func TestGaiaAppGenTx(t *testing.T) {
@@ -128,6 +139,24 @@ func TestGaiaGenesisValidation(t *testing.T) {
 	err = GaiaValidateGenesisState(genesisState)
 	require.Error(t, err)
 
+	// require invalid periodic vesting account fails validation (periods not
+	// adding up to the original vesting amount)
+	genesisState = makeGenesisState(t, genTxs)
+	genesisState.Accounts[0].OriginalVesting = genesisState.Accounts[0].Coins
+	genesisState.Accounts[0].StartTime = 1548775410
+	genesisState.Accounts[0].EndTime = 1548775410 + 7200
+	genesisState.Accounts[0].VestingPeriods = auth.Periods{
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(defaultBondDenom, 50))},
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(defaultBondDenom, 50))},
+	}
+	err = GaiaValidateGenesisState(genesisState)
+	require.Error(t, err)
+	genesisState.Accounts[0].VestingPeriods[1].Amount = sdk.NewCoins(sdk.NewInt64Coin(defaultBondDenom, 100))
+	require.NoError(t, GaiaValidateGenesisState(genesisState))
+	genesisState.Accounts[0].EndTime = 1548775410 + 3600
+	err = GaiaValidateGenesisState(genesisState)
+	require.Error(t, err)
+
 	// require bonded + jailed validator fails validation
 	genesisState = makeGenesisState(t, genTxs)
 	val1 := staking.NewValidator(addr1, pk1, staking.NewDescription("test #2", "", "", ""))
@@ -147,6 +176,49 @@ func TestGaiaGenesisValidation(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestGaiaGenesisValidationVestingPeriods(t *testing.T) {
+	genTxs := []auth.StdTx{makeMsg("test-0", pk1), makeMsg("test-1", pk2)}
+	half := sdk.NewCoins(sdk.NewInt64Coin(defaultBondDenom, 75))
+
+	tests := []struct {
+		name    string
+		periods auth.Periods
+		expPass bool
+	}{
+		{"valid periods", auth.Periods{{Length: 3600, Amount: half}, {Length: 3600, Amount: half}}, true},
+		{"zero length", auth.Periods{{Length: 7200, Amount: half}, {Length: 0, Amount: half}}, false},
+		{"negative length", auth.Periods{{Length: 10800, Amount: half}, {Length: -3600, Amount: half}}, false},
+		{"negative amount", auth.Periods{
+			{Length: 3600, Amount: half.Add(half).Add(half)},
+			{Length: 3600, Amount: sdk.Coins{sdk.Coin{Denom: defaultBondDenom, Amount: sdk.NewInt(-75)}}},
+		}, false},
+		{"invalid denomination", auth.Periods{
+			{Length: 3600, Amount: half},
+			{Length: 3600, Amount: sdk.Coins{sdk.Coin{Denom: "S", Amount: sdk.NewInt(75)}}},
+		}, false},
+		{"other denominations", auth.Periods{
+			{Length: 3600, Amount: half},
+			{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 75))},
+		}, false},
+		{"more than the original vesting", auth.Periods{{Length: 3600, Amount: half}, {Length: 3600, Amount: half.Add(half)}}, false},
+	}
+
+	for _, tc := range tests {
+		genesisState := makeGenesisState(t, genTxs)
+		genesisState.Accounts[0].OriginalVesting = half.Add(half)
+		genesisState.Accounts[0].StartTime = 1548775410
+		genesisState.Accounts[0].EndTime = 1548775410 + 7200
+		genesisState.Accounts[0].VestingPeriods = tc.periods
+
+		err := GaiaValidateGenesisState(genesisState)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
 func TestNewDefaultGenesisAccount(t *testing.T) {
 	addr := secp256k1.GenPrivKeySecp256k1([]byte("")).PubKey().Address()
 	acc := NewDefaultGenesisAccount(sdk.AccAddress(addr))
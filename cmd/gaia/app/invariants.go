@@ -7,18 +7,22 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
-	distrsim "github.com/cosmos/cosmos-sdk/x/distribution/simulation"
-	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
 )
 
-func (app *GaiaApp) runtimeInvariants() []sdk.Invariant {
-	return []sdk.Invariant{
-		banksim.NonnegativeBalanceInvariant(app.accountKeeper),
-		distrsim.NonNegativeOutstandingInvariant(app.distrKeeper),
-		stakingsim.SupplyInvariants(app.stakingKeeper, app.feeCollectionKeeper, app.distrKeeper, app.accountKeeper),
-		stakingsim.NonNegativePowerInvariant(app.stakingKeeper),
-	}
+var _ sdk.InvariantRouter = (*invariantRouter)(nil)
+
+// invariantRouter collects the invariants registered by the app's modules
+type invariantRouter struct {
+	routes []sdk.InvarRoute
+}
+
+// RegisterRoute implements sdk.InvariantRouter
+func (ir *invariantRouter) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, sdk.NewInvarRoute(moduleName, route, invar))
+}
+
+func (app *GaiaApp) runtimeInvariants() []sdk.InvarRoute {
+	return app.invarRouter.routes
 }
 
 func (app *GaiaApp) assertRuntimeInvariants() {
@@ -28,10 +32,10 @@ func (app *GaiaApp) assertRuntimeInvariants() {
 
 func (app *GaiaApp) assertRuntimeInvariantsOnContext(ctx sdk.Context) {
 	start := time.Now()
-	invariants := app.runtimeInvariants()
-	for _, inv := range invariants {
-		if err := inv(ctx); err != nil {
-			panic(fmt.Errorf("invariant broken: %s", err))
+	invarRoutes := app.runtimeInvariants()
+	for _, ir := range invarRoutes {
+		if err := ir.Invar(ctx); err != nil {
+			panic(fmt.Errorf("invariant broken: %s: %s", ir.FullRoute(), err))
 		}
 	}
 	end := time.Now()
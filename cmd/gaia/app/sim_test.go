@@ -34,6 +34,7 @@ import (
 	slashingsim "github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 var (
@@ -132,10 +133,13 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 				endTime = randIntBetween(r, int(startTime), int(startTime+(60*60*12)))
 			}
 
-			if r.Intn(100) < 50 {
+			switch r.Intn(3) {
+			case 0:
 				vacc = auth.NewContinuousVestingAccount(&bacc, startTime, int64(endTime))
-			} else {
+			case 1:
 				vacc = auth.NewDelayedVestingAccount(&bacc, int64(endTime))
+			default:
+				vacc = auth.NewPeriodicVestingAccount(&bacc, startTime, randVestingPeriods(r, coins, int64(endTime)-startTime))
 			}
 
 			gacc = NewGenesisAccountI(vacc)
@@ -153,11 +157,16 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 			TxSizeCostPerByte:      uint64(randIntBetween(r, 5, 15)),
 			SigVerifyCostED25519:   uint64(randIntBetween(r, 500, 1000)),
 			SigVerifyCostSecp256k1: uint64(randIntBetween(r, 500, 1000)),
+			GasSchedule:            sdk.DefaultGasSchedule(),
 		},
 	}
 	fmt.Printf("Selected randomly generated auth parameters:\n\t%+v\n", authGenesis)
 
-	bankGenesis := bank.NewGenesisState(r.Int63n(2) == 0)
+	var sendEnabledDenoms []bank.SendEnabled
+	if r.Int63n(2) == 0 {
+		sendEnabledDenoms = []bank.SendEnabled{bank.NewSendEnabled(sdk.DefaultBondDenom, r.Int63n(2) == 0)}
+	}
+	bankGenesis := bank.NewGenesisState(r.Int63n(2) == 0, sendEnabledDenoms)
 	fmt.Printf("Selected randomly generated bank parameters:\n\t%+v\n", bankGenesis)
 
 	// Random genesis states
@@ -234,6 +243,18 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 	stakingGenesis.Validators = validators
 	stakingGenesis.Delegations = delegations
 
+	// the initially bonded tokens are held by the staking module account
+	stakingAcc := auth.NewEmptyModuleAccount(staking.ModuleName, maccPerms[staking.ModuleName]...)
+	stakingAcc.SetCoins(sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount*numInitiallyBonded))})
+	genesisAccounts = append(genesisAccounts, NewGenesisAccountI(stakingAcc))
+
+	// account balances are liquid while the initially bonded tokens are held by staking
+	supplyGenesis := supply.NewGenesisState(supply.NewSupply(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount*numAccs))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount*numInitiallyBonded))),
+	))
+	fmt.Printf("Selected randomly generated supply:\n\t%+v\n", supplyGenesis)
+
 	distrGenesis := distr.GenesisState{
 		FeePool:             distr.InitialFeePool(),
 		CommunityTax:        sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2)),
@@ -246,6 +267,7 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 		Accounts:     genesisAccounts,
 		AuthData:     authGenesis,
 		BankData:     bankGenesis,
+		SupplyData:   supplyGenesis,
 		StakingData:  stakingGenesis,
 		MintData:     mintGenesis,
 		DistrData:    distrGenesis,
@@ -273,9 +295,32 @@ func randIntBetween(r *rand.Rand, min, max int) int {
 	return r.Intn(max-min) + min
 }
 
+// randVestingPeriods splits the given coins and schedule length into a random
+// number of equal vesting periods, the last one vesting any remainder.
+func randVestingPeriods(r *rand.Rand, coins sdk.Coins, length int64) auth.Periods {
+	numPeriods := int64(r.Intn(5) + 1)
+	periods := make(auth.Periods, numPeriods)
+
+	var vested sdk.Coins
+	for i := int64(0); i < numPeriods-1; i++ {
+		var amount sdk.Coins
+		for _, coin := range coins {
+			amount = append(amount, sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(numPeriods)))
+		}
+		periods[i] = auth.Period{Length: length / numPeriods, Amount: amount}
+		vested = vested.Add(amount)
+	}
+
+	periods[numPeriods-1] = auth.Period{
+		Length: length - (numPeriods-1)*(length/numPeriods),
+		Amount: coins.Sub(vested),
+	}
+	return periods
+}
+
 func testAndRunTxs(app *GaiaApp) []simulation.WeightedOperation {
 	return []simulation.WeightedOperation{
-		{5, authsim.SimulateDeductFee(app.accountKeeper, app.feeCollectionKeeper)},
+		{5, authsim.SimulateDeductFee(app.accountKeeper, app.feeCollectionKeeper, app.supplyKeeper)},
 		{100, banksim.SimulateMsgSend(app.accountKeeper, app.bankKeeper)},
 		{10, banksim.SimulateSingleInputMsgMultiSend(app.accountKeeper, app.bankKeeper)},
 		{50, distrsim.SimulateMsgSetWithdrawAddress(app.accountKeeper, app.distrKeeper)},
@@ -294,11 +339,11 @@ func testAndRunTxs(app *GaiaApp) []simulation.WeightedOperation {
 
 func invariants(app *GaiaApp) []sdk.Invariant {
 	return []sdk.Invariant{
-		simulation.PeriodicInvariant(banksim.NonnegativeBalanceInvariant(app.accountKeeper), period, 0),
+		simulation.PeriodicInvariant(bank.NonnegativeBalanceInvariant(app.accountKeeper), period, 0),
+		simulation.PeriodicInvariant(supply.TotalSupplyInvariant(app.supplyKeeper, app.accountKeeper), period, 0),
 		simulation.PeriodicInvariant(govsim.AllInvariants(), period, 0),
-		simulation.PeriodicInvariant(distrsim.AllInvariants(app.distrKeeper, app.stakingKeeper), period, 0),
-		simulation.PeriodicInvariant(stakingsim.AllInvariants(app.stakingKeeper, app.feeCollectionKeeper,
-			app.distrKeeper, app.accountKeeper), period, 0),
+		simulation.PeriodicInvariant(distr.AllInvariants(app.distrKeeper, app.stakingKeeper), period, 0),
+		simulation.PeriodicInvariant(staking.AllInvariants(app.stakingKeeper, app.accountKeeper), period, 0),
 		simulation.PeriodicInvariant(slashingsim.AllInvariants(), period, 0),
 	}
 }
@@ -424,8 +469,13 @@ func TestGaiaImportExport(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
+	var moduleGenesis map[string]json.RawMessage
+	err = json.Unmarshal(appState, &moduleGenesis)
+	if err != nil {
+		panic(err)
+	}
 	ctxB := newApp.NewContext(true, abci.Header{})
-	newApp.initFromGenesisState(ctxB, genesisState)
+	newApp.initFromGenesisState(ctxB, genesisState, moduleGenesis)
 
 	fmt.Printf("Comparing stores...\n")
 	ctxA := app.NewContext(true, abci.Header{})
@@ -445,6 +495,7 @@ func TestGaiaImportExport(t *testing.T) {
 		{app.keyFeeCollection, newApp.keyFeeCollection, [][]byte{}},
 		{app.keyParams, newApp.keyParams, [][]byte{}},
 		{app.keyGov, newApp.keyGov, [][]byte{}},
+		{app.keyUpgrade, newApp.keyUpgrade, [][]byte{}},
 	}
 	for _, storeKeysPrefix := range storeKeysPrefixes {
 		storeKeyA := storeKeysPrefix.A
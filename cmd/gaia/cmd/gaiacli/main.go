@@ -14,6 +14,7 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/lcd"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -28,18 +29,30 @@ import (
 	dist "github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
 	gv "github.com/cosmos/cosmos-sdk/x/gov"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	ib "github.com/cosmos/cosmos-sdk/x/ibc"
+	ibc "github.com/cosmos/cosmos-sdk/x/ibc/client/rest"
 	sl "github.com/cosmos/cosmos-sdk/x/slashing"
 	slashing "github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
 	st "github.com/cosmos/cosmos-sdk/x/staking"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/client/rest"
+	sp "github.com/cosmos/cosmos-sdk/x/supply"
+	supply "github.com/cosmos/cosmos-sdk/x/supply/client/rest"
+	up "github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade/client/rest"
 
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	distcmd "github.com/cosmos/cosmos-sdk/x/distribution"
 	distClient "github.com/cosmos/cosmos-sdk/x/distribution/client"
+	distrcli "github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
 	govClient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	ibcClient "github.com/cosmos/cosmos-sdk/x/ibc/client"
+	ibccmd "github.com/cosmos/cosmos-sdk/x/ibc/client/cli"
 	slashingClient "github.com/cosmos/cosmos-sdk/x/slashing/client"
 	stakingClient "github.com/cosmos/cosmos-sdk/x/staking/client"
+	supplyClient "github.com/cosmos/cosmos-sdk/x/supply/client"
+	upgradeClient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
+	upgradecli "github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
 
 	_ "github.com/cosmos/cosmos-sdk/client/lcd/statik"
 )
@@ -65,12 +78,25 @@ func main() {
 	// Module clients hold cli commnads (tx,query) and lcd routes
 	// TODO: Make the lcd command take a list of ModuleClient
 	mc := []sdk.ModuleClients{
-		govClient.NewModuleClient(gv.StoreKey, cdc),
+		govClient.NewModuleClient(gv.StoreKey, cdc,
+			upgradecli.GetCmdSubmitUpgradeProposal(cdc),
+			distrcli.GetCmdSubmitProposal(cdc),
+			ibccmd.GetCmdSubmitCreateClientProposal(cdc),
+		),
 		distClient.NewModuleClient(distcmd.StoreKey, cdc),
 		stakingClient.NewModuleClient(st.StoreKey, cdc),
 		slashingClient.NewModuleClient(sl.StoreKey, cdc),
+		supplyClient.NewModuleClient(sp.StoreKey, cdc),
+		upgradeClient.NewModuleClient(up.StoreKey, cdc),
+		ibcClient.NewModuleClient(ib.StoreKey, cdc),
 	}
 
+	// Custom queries backed by these store keys are verified against Merkle
+	// proofs unless the node is trusted
+	context.RegisterProvableQueries(at.ProvableQueries(cdc))
+	context.RegisterProvableQueries(st.ProvableQueries(cdc))
+	context.RegisterProvableQueries(gv.ProvableQueries(cdc))
+
 	rootCmd := &cobra.Command{
 		Use:   "gaiacli",
 		Short: "Command line interface for interacting with gaiad",
@@ -90,6 +116,7 @@ func main() {
 		txCmd(cdc, mc),
 		client.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
+		ibccmd.IBCRelayerCmd(cdc),
 		client.LineBreak,
 		keys.Commands(),
 		client.LineBreak,
@@ -117,6 +144,7 @@ func queryCmd(cdc *amino.Codec, mc []sdk.ModuleClients) *cobra.Command {
 	queryCmd.AddCommand(
 		rpc.ValidatorCommand(cdc),
 		rpc.BlockCommand(),
+		rpc.PruningStatusCommand(cdc),
 		tx.SearchTxCmd(cdc),
 		tx.QueryTxCmd(cdc),
 		client.LineBreak,
@@ -165,7 +193,14 @@ func registerRoutes(rs *lcd.RestServer) {
 	dist.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, distcmd.StoreKey)
 	staking.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	slashing.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
-	gov.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	gov.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc,
+		upgrade.ProposalRESTHandler(rs.CliCtx, rs.Cdc),
+		dist.ProposalRESTHandler(rs.CliCtx, rs.Cdc),
+		ibc.ProposalRESTHandler(rs.CliCtx, rs.Cdc),
+	)
+	upgrade.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, up.QuerierRoute)
+	supply.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, sp.QuerierRoute)
+	ibc.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 }
 
 func registerSwaggerUI(rs *lcd.RestServer) {
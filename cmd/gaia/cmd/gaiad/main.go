@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -18,7 +19,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	gaiaInit "github.com/cosmos/cosmos-sdk/cmd/gaia/init"
 	"github.com/cosmos/cosmos-sdk/server"
-	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -58,24 +58,42 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
+	pruningOpts, err := server.GetPruningOptionsFromFlags()
+	if err != nil {
+		panic(err)
+	}
+
+	storeDBs, err := server.OpenStoreDBs(viper.GetString(cli.HomeFlag))
+	if err != nil {
+		panic(err)
+	}
+
 	return app.NewGaiaApp(
 		logger, db, traceStore, true,
-		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))),
+		baseapp.SetPruning(pruningOpts),
+		baseapp.SetStoreDBs(storeDBs),
 		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
+		baseapp.SetHaltHeight(cast.ToUint64(viper.Get(server.FlagHaltHeight))),
+		baseapp.SetHaltTime(cast.ToUint64(viper.Get(server.FlagHaltTime))),
 	)
 }
 
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string,
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
+	storeDBs, err := server.OpenStoreDBs(viper.GetString(cli.HomeFlag))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if height != -1 {
-		gApp := app.NewGaiaApp(logger, db, traceStore, false)
+		gApp := app.NewGaiaApp(logger, db, traceStore, false, baseapp.SetStoreDBs(storeDBs))
 		err := gApp.LoadHeight(height)
 		if err != nil {
 			return nil, nil, err
 		}
 		return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 	}
-	gApp := app.NewGaiaApp(logger, db, traceStore, true)
+	gApp := app.NewGaiaApp(logger, db, traceStore, true, baseapp.SetStoreDBs(storeDBs))
 	return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
@@ -2,6 +2,7 @@ package init
 
 import (
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -53,6 +54,18 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 				return err
 			}
 
+			var vestingPeriods auth.Periods
+			if periodsFile := viper.GetString(flagVestingPer); periodsFile != "" {
+				bz, err := ioutil.ReadFile(periodsFile)
+				if err != nil {
+					return err
+				}
+
+				if err = cdc.UnmarshalJSON(bz, &vestingPeriods); err != nil {
+					return err
+				}
+			}
+
 			genFile := config.GenesisFile()
 			if !common.FileExists(genFile) {
 				return fmt.Errorf("%s does not exist, run `gaiad init` first", genFile)
@@ -68,7 +81,9 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 				return err
 			}
 
-			appState, err = addGenesisAccount(cdc, appState, addr, coins, vestingAmt, vestingStart, vestingEnd)
+			appState, err = addGenesisAccount(
+				cdc, appState, addr, coins, vestingAmt, vestingStart, vestingEnd, vestingPeriods,
+			)
 			if err != nil {
 				return err
 			}
@@ -87,13 +102,14 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPer, "", "JSON file of the vesting periods, each with a length in seconds and an amount, for periodic vesting accounts")
 
 	return cmd
 }
 
 func addGenesisAccount(
 	cdc *codec.Codec, appState app.GenesisState, addr sdk.AccAddress,
-	coins, vestingAmt sdk.Coins, vestingStart, vestingEnd int64,
+	coins, vestingAmt sdk.Coins, vestingStart, vestingEnd int64, vestingPeriods auth.Periods,
 ) (app.GenesisState, error) {
 
 	for _, stateAcc := range appState.Accounts {
@@ -105,6 +121,20 @@ func addGenesisAccount(
 	acc := auth.NewBaseAccountWithAddress(addr)
 	acc.Coins = coins
 
+	// the periods of a periodic vesting account define its vesting amount and
+	// end time
+	if len(vestingPeriods) > 0 {
+		if !vestingAmt.IsZero() || vestingEnd != 0 {
+			return appState, fmt.Errorf("vesting amount and end time cannot be set with vesting periods")
+		}
+
+		vestingAmt = vestingPeriods.TotalAmount()
+		vestingEnd = vestingStart + vestingPeriods.TotalLength()
+		if err := vestingPeriods.Validate(vestingAmt); err != nil {
+			return appState, err
+		}
+	}
+
 	if !vestingAmt.IsZero() {
 		var vacc auth.VestingAccount
 
@@ -121,7 +151,13 @@ func addGenesisAccount(
 			return appState, fmt.Errorf("vesting start time must before end time")
 		}
 
-		if vestingStart != 0 {
+		if len(vestingPeriods) > 0 {
+			vacc = &auth.PeriodicVestingAccount{
+				BaseVestingAccount: bvacc,
+				StartTime:          vestingStart,
+				VestingPeriods:     vestingPeriods,
+			}
+		} else if vestingStart != 0 {
 			vacc = &auth.ContinuousVestingAccount{
 				BaseVestingAccount: bvacc,
 				StartTime:          vestingStart,
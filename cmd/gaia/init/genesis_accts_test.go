@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
 func TestAddGenesisAccount(t *testing.T) {
@@ -21,6 +22,7 @@ func TestAddGenesisAccount(t *testing.T) {
 		vestingAmt   sdk.Coins
 		vestingStart int64
 		vestingEnd   int64
+		vestingPer   auth.Periods
 	}
 	tests := []struct {
 		name    string
@@ -36,6 +38,7 @@ func TestAddGenesisAccount(t *testing.T) {
 				sdk.NewCoins(),
 				0,
 				0,
+				nil,
 			},
 			false,
 		},
@@ -48,6 +51,7 @@ func TestAddGenesisAccount(t *testing.T) {
 				sdk.NewCoins(),
 				0,
 				0,
+				nil,
 			},
 			true,
 		},
@@ -60,6 +64,7 @@ func TestAddGenesisAccount(t *testing.T) {
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
 				0,
 				0,
+				nil,
 			},
 			true,
 		},
@@ -72,6 +77,86 @@ func TestAddGenesisAccount(t *testing.T) {
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
 				1654668078,
 				1554668078,
+				nil,
+			},
+			true,
+		},
+		{
+			"valid periodic vesting account",
+			args{
+				app.GenesisState{},
+				addr1,
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				sdk.NewCoins(),
+				1554668078,
+				0,
+				auth.Periods{
+					{Length: 2592000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+					{Length: 2592000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+				},
+			},
+			false,
+		},
+		{
+			"invalid periodic vesting amount",
+			args{
+				app.GenesisState{},
+				addr1,
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				sdk.NewCoins(),
+				1554668078,
+				0,
+				auth.Periods{
+					{Length: 2592000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+					{Length: 2592000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+				},
+			},
+			true,
+		},
+		{
+			"invalid vesting period length",
+			args{
+				app.GenesisState{},
+				addr1,
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				sdk.NewCoins(),
+				1554668078,
+				0,
+				auth.Periods{
+					{Length: 5184000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+					{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+				},
+			},
+			true,
+		},
+		{
+			"invalid vesting period amount",
+			args{
+				app.GenesisState{},
+				addr1,
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				sdk.NewCoins(),
+				1554668078,
+				0,
+				auth.Periods{
+					{Length: 2592000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 150))},
+					{Length: 2592000, Amount: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-50)}}},
+				},
+			},
+			true,
+		},
+		{
+			"vesting periods with end time",
+			args{
+				app.GenesisState{},
+				addr1,
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				sdk.NewCoins(),
+				1554668078,
+				1654668078,
+				auth.Periods{
+					{Length: 2592000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+				},
 			},
 			true,
 		},
@@ -80,7 +165,7 @@ func TestAddGenesisAccount(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			_, err := addGenesisAccount(
 				cdc, tt.args.appState, tt.args.addr, tt.args.coins,
-				tt.args.vestingAmt, tt.args.vestingStart, tt.args.vestingEnd,
+				tt.args.vestingAmt, tt.args.vestingStart, tt.args.vestingEnd, tt.args.vestingPer,
 			)
 			require.Equal(t, tt.wantErr, (err != nil))
 		})
@@ -24,6 +24,7 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagVestingPer   = "vesting-periods"
 )
 
 type printInfo struct {
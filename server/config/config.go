@@ -7,7 +7,9 @@ import (
 )
 
 const (
-	defaultMinGasPrices = ""
+	defaultMinGasPrices    = ""
+	defaultPruning         = "syncable"
+	defaultPruningInterval = 10
 )
 
 // BaseConfig defines the server's basic configuration
@@ -16,11 +18,54 @@ type BaseConfig struct {
 	// transaction. A transaction's fees must meet the minimum of any denomination
 	// specified in this config (e.g. 0.01photino;0.0001stake).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
+
+	// HaltHeight contains a non-zero block height at which a node will
+	// gracefully halt and shutdown that can be used to assist upgrades and
+	// testing.
+	HaltHeight uint64 `mapstructure:"halt-height"`
+
+	// HaltTime contains a non-zero minimum block time (in Unix seconds) at
+	// which a node will gracefully halt and shutdown that can be used to assist
+	// upgrades and testing.
+	HaltTime uint64 `mapstructure:"halt-time"`
+
+	// Pruning is the pruning strategy: syncable, nothing, everything or
+	// custom.
+	Pruning string `mapstructure:"pruning"`
+
+	// PruningKeepRecent and PruningKeepEvery set the number of recent states
+	// to keep and keep every Nth state with the custom pruning strategy.
+	PruningKeepRecent int64 `mapstructure:"pruning-keep-recent"`
+	PruningKeepEvery  int64 `mapstructure:"pruning-keep-every"`
+
+	// PruningInterval is the number of blocks between two prunings of the
+	// states released by the pruning strategy.
+	PruningInterval int64 `mapstructure:"pruning-interval"`
+
+	// PruningBackground deletes released states in the background instead of
+	// during commit.
+	PruningBackground bool `mapstructure:"pruning-background"`
+}
+
+// StoreDBConfig defines the database backing a mounted store
+type StoreDBConfig struct {
+	// Backend is the database backend, e.g. goleveldb, cleveldb (when built
+	// with gcc) or memdb. boltdb is not provided by the tendermint version the
+	// SDK depends on.
+	Backend string `mapstructure:"backend"`
+
+	// Dir is the directory of the database, the node's data directory if
+	// empty.
+	Dir string `mapstructure:"dir"`
 }
 
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	// StoreDBs holds the databases of the stores that are not backed by the
+	// application database, by store key name.
+	StoreDBs map[string]StoreDBConfig `mapstructure:"store-dbs"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -42,8 +87,10 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		BaseConfig{
-			MinGasPrices: defaultMinGasPrices,
+		BaseConfig: BaseConfig{
+			MinGasPrices:    defaultMinGasPrices,
+			Pruning:         defaultPruning,
+			PruningInterval: defaultPruningInterval,
 		},
 	}
 }
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +22,27 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestStoreDBsConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.StoreDBs = map[string]StoreDBConfig{
+		"acc": {Backend: "cleveldb", Dir: "/mnt/fast"},
+	}
+
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "gaiad.toml")
+	WriteConfigFile(path, cfg)
+
+	viper.Reset()
+	defer viper.Reset()
+	viper.SetConfigFile(path)
+	require.NoError(t, viper.ReadInConfig())
+
+	parsed, err := ParseConfig()
+	require.NoError(t, err)
+	require.Equal(t, cfg.StoreDBs, parsed.StoreDBs)
+	require.Equal(t, cfg.Pruning, parsed.Pruning)
+}
@@ -17,7 +17,51 @@ const defaultConfigTemplate = `# This is a TOML config file.
 # transaction. A transaction's fees must meet the minimum of any denomination
 # specified in this config (e.g. 0.01photino;0.0001stake).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
-`
+
+# HaltHeight contains a non-zero block height at which a node will gracefully
+# halt and shutdown that can be used to assist upgrades and testing.
+halt-height = {{ .BaseConfig.HaltHeight }}
+
+# HaltTime contains a non-zero minimum block time (in Unix seconds) at which
+# a node will gracefully halt and shutdown that can be used to assist upgrades
+# and testing.
+halt-time = {{ .BaseConfig.HaltTime }}
+
+# Pruning strategy: syncable, nothing, everything or custom. The syncable
+# strategy keeps the last 100 states and every 10000th state.
+pruning = "{{ .BaseConfig.Pruning }}"
+
+# With the custom strategy, the number of recent states to keep and the
+# distance between states kept regardless of age, 0 keeping none of those.
+pruning-keep-recent = {{ .BaseConfig.PruningKeepRecent }}
+pruning-keep-every = {{ .BaseConfig.PruningKeepEvery }}
+
+# Number of blocks between two prunings of the states released by the
+# strategy, which are deleted together.
+pruning-interval = {{ .BaseConfig.PruningInterval }}
+
+# Delete released states in the background instead of during commit, which
+# keeps commits fast on large databases.
+pruning-background = {{ .BaseConfig.PruningBackground }}
+
+##### store database options #####
+
+# Stores may be backed by their own database instead of the application
+# database, e.g. to put a frequently accessed store on a faster disk. Tables are
+# named after the store keys; the backend is one of goleveldb, cleveldb (when
+# built with gcc) or memdb (not persisted, for testing only), and the directory
+# defaults to the data directory. boltdb is not supported, as the tendermint
+# version gaiad is built with does not provide it.
+# Use the migrate-store-db command to move an existing store, e.g.:
+#
+# [store-dbs.acc]
+# backend = "goleveldb"
+# dir = "/mnt/fast/gaiad"
+{{ range $name, $db := .StoreDBs }}
+[store-dbs.{{ $name }}]
+backend = "{{ $db.Backend }}"
+dir = "{{ $db.Dir }}"
+{{ end }}`
 
 var configTemplate *template.Template
 
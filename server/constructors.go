@@ -2,10 +2,12 @@ package server
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 
+	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -29,6 +31,44 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return db, err
 }
 
+// OpenStoreDBs opens the databases of the stores set in the store-dbs section
+// of the server config, by store key name.
+func OpenStoreDBs(rootDir string) (map[string]dbm.DB, error) {
+	conf, err := config.ParseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	dbs := make(map[string]dbm.DB, len(conf.StoreDBs))
+	for name, storeConf := range conf.StoreDBs {
+		db, err := openStoreDB(rootDir, name, storeConf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open the database of store %s: %v", name, err)
+		}
+		dbs[name] = db
+	}
+
+	return dbs, nil
+}
+
+// storeDBDir returns the directory of the database of a store, the data
+// directory by default.
+func storeDBDir(rootDir string, conf config.StoreDBConfig) string {
+	if conf.Dir == "" {
+		return filepath.Join(rootDir, "data")
+	}
+	return conf.Dir
+}
+
+func openStoreDB(rootDir, name string, conf config.StoreDBConfig) (db dbm.DB, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("couldn't create db: %v", r)
+		}
+	}()
+	return dbm.NewDB("store-"+name, dbm.DBBackendType(conf.Backend), storeDBDir(rootDir, conf)), nil
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile != "" {
 		w, err = os.OpenFile(
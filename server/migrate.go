@@ -0,0 +1,96 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/store"
+)
+
+// MigrateStoreDBCmd returns the command to copy the state of a store to a
+// database of another backend or directory
+func MigrateStoreDBCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-store-db [store-key] [backend] [dir]",
+		Short: "Copy the state of a store to a database of another backend or directory",
+		Long: `Copy the IAVL tree of a store, with all its stored versions, from its current
+database to a new database of the given backend in the given directory, the
+data directory by default, and check that the copied tree has the root hash of
+the latest committed version. The node must be stopped.
+
+The store reads from the new database once the store-dbs section of the server
+config (config/gaiad.toml) is updated as printed. The old database is left
+untouched.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx.Config.SetRoot(viper.GetString(cli.HomeFlag))
+			home := ctx.Config.RootDir
+
+			name := args[0]
+			dest := config.StoreDBConfig{Backend: args[1]}
+			if len(args) > 2 {
+				dir, err := filepath.Abs(args[2])
+				if err != nil {
+					return err
+				}
+				dest.Dir = dir
+			}
+			if dest.Backend == string(dbm.MemDBBackend) {
+				return fmt.Errorf("%s does not persist the state", dest.Backend)
+			}
+
+			conf, err := config.ParseConfig()
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(home)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			var from dbm.DB
+			if current, ok := conf.StoreDBs[name]; ok {
+				if storeDBDir(home, current) == storeDBDir(home, dest) {
+					return fmt.Errorf("store %s already has a database in %s", name, storeDBDir(home, dest))
+				}
+				if from, err = openStoreDB(home, name, current); err != nil {
+					return err
+				}
+				defer from.Close()
+			}
+
+			to, err := openStoreDB(home, name, dest)
+			if err != nil {
+				return err
+			}
+			defer to.Close()
+
+			ctx.Logger.Info("migrating store", "store", name, "backend", dest.Backend, "dir", storeDBDir(home, dest))
+			id, err := store.MigrateStoreDB(db, name, from, to)
+			if err != nil {
+				return fmt.Errorf("failed to migrate store %s: %v", name, err)
+			}
+
+			fmt.Printf(`Copied store %s at version %d with root hash %X to a %s database in %s.
+Set in the server config:
+
+[store-dbs.%s]
+backend = %q
+dir = %q
+`, name, id.Version, id.Hash, dest.Backend, storeDBDir(home, dest), name, dest.Backend, dest.Dir)
+			return nil
+		},
+	}
+
+	return cmd
+}
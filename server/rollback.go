@@ -0,0 +1,68 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rollbackApp is implemented by applications built on a BaseApp whose
+// multistore supports rollbacks
+type rollbackApp interface {
+	sdk.Rollbacker
+	LastCommitID() sdk.CommitID
+}
+
+// RollbackCmd returns the command to revert the application state to a
+// previous committed height
+func RollbackCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Revert the application state to a previous committed height",
+		Long: `Revert the application state to a previous committed height, which must not
+have been pruned, deleting the state of all later heights. By default the state
+is reverted by a single height, e.g. to recover from a binary that committed a
+wrong app hash at the latest height. The node must be stopped.
+
+Tendermint's block store and state are left untouched, so on the next start
+Tendermint replays its stored blocks after the reverted height against the
+application.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			app, ok := appCreator(ctx.Logger, db, nil).(rollbackApp)
+			if !ok {
+				return fmt.Errorf("application does not support rollbacks")
+			}
+
+			latest := app.LastCommitID().Version
+			height := viper.GetInt64(flagHeight)
+			if height <= 0 {
+				height = latest - 1
+			}
+
+			if err := app.Rollback(height); err != nil {
+				return fmt.Errorf("error rolling back state from height %d to %d: %v", latest, height, err)
+			}
+
+			commitID := app.LastCommitID()
+			fmt.Printf("Rolled back state from height %d to %d, app hash %X\n", latest, commitID.Version, commitID.Hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height to roll back to (0 means the height before the latest)")
+	return cmd
+}
@@ -0,0 +1,162 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// snapshotApp is implemented by applications built on a BaseApp whose
+// multistore supports snapshots
+type snapshotApp interface {
+	sdk.Snapshotter
+	LastCommitID() sdk.CommitID
+}
+
+// SnapshotCmd returns the command to manage state-sync snapshots of the
+// application state
+func SnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Create, list, export and import application state snapshots",
+	}
+
+	cmd.AddCommand(
+		snapshotCreateCmd(ctx, appCreator),
+		snapshotListCmd(ctx),
+		snapshotExportCmd(ctx),
+		snapshotImportCmd(ctx, appCreator),
+	)
+	return cmd
+}
+
+func snapshotCreateCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a snapshot of the application state at a height",
+		Long: `Create a snapshot of the application state at a height, which must not have
+been pruned. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := openSnapshotApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+
+			height := viper.GetInt64(flagHeight)
+			if height <= 0 {
+				height = app.LastCommitID().Version
+			}
+			if height == 0 {
+				return fmt.Errorf("state is not initialized")
+			}
+
+			meta, err := snapshotStore(ctx).Create(app, height)
+			if err != nil {
+				return fmt.Errorf("error creating snapshot: %v", err)
+			}
+
+			fmt.Printf("Created snapshot at height %d with %d chunks, app hash %X\n",
+				meta.Height, len(meta.Chunks), meta.AppHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height of the snapshot (0 means latest height)")
+	return cmd
+}
+
+func snapshotListCmd(ctx *Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the stored snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotList, err := snapshotStore(ctx).List()
+			if err != nil {
+				return err
+			}
+			for _, meta := range snapshotList {
+				fmt.Printf("height: %d\tchunks: %d\tapp hash: %X\n", meta.Height, len(meta.Chunks), meta.AppHash)
+			}
+			return nil
+		},
+	}
+}
+
+func snapshotExportCmd(ctx *Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [height] [dir]",
+		Short: "Export a stored snapshot into a new directory",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			return snapshotStore(ctx).Export(height, args[1])
+		},
+	}
+}
+
+func snapshotImportCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [dir]",
+		Short: "Import an exported snapshot and restore the application state from it",
+		Long: `Import an exported snapshot and restore the application state from it. The
+application state must be empty. The restore fails unless the restored state
+matches the height and app hash of the snapshot.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := openSnapshotApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+			if height := app.LastCommitID().Version; height != 0 {
+				return fmt.Errorf("state is already initialized at height %d", height)
+			}
+
+			store := snapshotStore(ctx)
+			meta, err := store.Import(args[0])
+			if err != nil {
+				return fmt.Errorf("error importing snapshot: %v", err)
+			}
+			if _, err := store.Restore(app, meta.Height); err != nil {
+				return fmt.Errorf("error restoring snapshot: %v", err)
+			}
+
+			fmt.Printf("Restored state at height %d, app hash %X\n", meta.Height, meta.AppHash)
+			return nil
+		},
+	}
+}
+
+func openSnapshotApp(ctx *Context, appCreator AppCreator) (snapshotApp, error) {
+	config := ctx.Config
+	config.SetRoot(viper.GetString(cli.HomeFlag))
+
+	db, err := openDB(config.RootDir)
+	if err != nil {
+		return nil, err
+	}
+	app, ok := appCreator(ctx.Logger, db, nil).(snapshotApp)
+	if !ok {
+		return nil, fmt.Errorf("application does not support snapshots")
+	}
+	return app, nil
+}
+
+func snapshotStore(ctx *Context) *snapshots.Store {
+	config := ctx.Config
+	config.SetRoot(viper.GetString(cli.HomeFlag))
+	return snapshots.NewStore(filepath.Join(config.RootDir, "data", "snapshots"))
+}
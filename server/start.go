@@ -14,15 +14,23 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+
+	"github.com/cosmos/cosmos-sdk/store"
 )
 
 // Tendermint full-node start flags
 const (
-	flagWithTendermint = "with-tendermint"
-	flagAddress        = "address"
-	flagTraceStore     = "trace-store"
-	flagPruning        = "pruning"
-	FlagMinGasPrices   = "minimum-gas-prices"
+	flagWithTendermint    = "with-tendermint"
+	flagAddress           = "address"
+	flagTraceStore        = "trace-store"
+	FlagPruning           = "pruning"
+	FlagPruningKeepRecent = "pruning-keep-recent"
+	FlagPruningKeepEvery  = "pruning-keep-every"
+	FlagPruningInterval   = "pruning-interval"
+	FlagPruningBackground = "pruning-background"
+	FlagMinGasPrices      = "minimum-gas-prices"
+	FlagHaltHeight        = "halt-height"
+	FlagHaltTime          = "halt-time"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -32,6 +40,10 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 		Use:   "start",
 		Short: "Run the full node",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := GetPruningOptionsFromFlags(); err != nil {
+				return err
+			}
+
 			if !viper.GetBool(flagWithTendermint) {
 				ctx.Logger.Info("Starting ABCI without Tendermint")
 				return startStandAlone(ctx, appCreator)
@@ -48,17 +60,52 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 	cmd.Flags().Bool(flagWithTendermint, true, "Run abci app embedded in-process with tendermint")
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
-	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
+	cmd.Flags().String(FlagPruning, "syncable", "Pruning strategy: syncable, nothing, everything or custom")
+	cmd.Flags().Int64(FlagPruningKeepRecent, 0, "Number of recent states to keep with the custom pruning strategy")
+	cmd.Flags().Int64(FlagPruningKeepEvery, 0, "Keep every Nth state with the custom pruning strategy, 0 keeping none")
+	cmd.Flags().Int64(FlagPruningInterval, 10, "Number of blocks between two prunings of released states")
+	cmd.Flags().Bool(FlagPruningBackground, false, "Delete released states in the background instead of during commit")
 	cmd.Flags().String(
 		FlagMinGasPrices, "",
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
 	)
+	cmd.Flags().Uint64(FlagHaltHeight, 0, "Height at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
 	return cmd
 }
 
+// GetPruningOptionsFromFlags returns the pruning options set by the pruning
+// flags, which may also be set in config/gaiad.toml. The keep-recent and
+// keep-every options only apply to the custom pruning strategy.
+func GetPruningOptionsFromFlags() (store.PruningOptions, error) {
+	var opts store.PruningOptions
+
+	switch strategy := viper.GetString(FlagPruning); strategy {
+	case "syncable", "nothing", "everything":
+		opts = store.NewPruningOptionsFromString(strategy)
+
+	case "custom":
+		opts = store.NewPruningOptions(
+			viper.GetInt64(FlagPruningKeepRecent), viper.GetInt64(FlagPruningKeepEvery),
+		)
+
+	default:
+		return opts, fmt.Errorf("unknown pruning strategy %q, expected syncable, nothing, everything or custom", strategy)
+	}
+
+	opts = opts.
+		WithInterval(viper.GetInt64(FlagPruningInterval)).
+		WithBackground(viper.GetBool(FlagPruningBackground))
+	if err := opts.Validate(); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
 func startStandAlone(ctx *Context, appCreator AppCreator) error {
 	addr := viper.GetString(flagAddress)
 	home := viper.GetString("home")
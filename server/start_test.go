@@ -0,0 +1,42 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store"
+)
+
+func TestGetPruningOptionsFromFlags(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set(FlagPruning, "syncable")
+	viper.Set(FlagPruningKeepRecent, 5)
+	viper.Set(FlagPruningInterval, 10)
+	opts, err := GetPruningOptionsFromFlags()
+	require.NoError(t, err)
+	require.Equal(t, store.PruneSyncable.WithInterval(10), opts)
+
+	// keep-recent and keep-every only apply to the custom strategy
+	viper.Set(FlagPruning, "custom")
+	viper.Set(FlagPruningKeepEvery, 100)
+	viper.Set(FlagPruningBackground, true)
+	opts, err = GetPruningOptionsFromFlags()
+	require.NoError(t, err)
+	require.Equal(t, store.NewPruningOptions(5, 100).WithInterval(10).WithBackground(true), opts)
+
+	viper.Set(FlagPruningKeepRecent, -1)
+	_, err = GetPruningOptionsFromFlags()
+	require.Error(t, err)
+
+	viper.Set(FlagPruningKeepRecent, 5)
+	viper.Set(FlagPruningInterval, 0)
+	_, err = GetPruningOptionsFromFlags()
+	require.Error(t, err)
+
+	viper.Set(FlagPruning, "sometimes")
+	_, err = GetPruningOptionsFromFlags()
+	require.Error(t, err)
+}
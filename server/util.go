@@ -152,6 +152,9 @@ func AddCommands(
 		client.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
+		SnapshotCmd(ctx, appCreator),
+		RollbackCmd(ctx, appCreator),
+		MigrateStoreDBCmd(ctx),
 		client.LineBreak,
 		version.VersionCmd,
 	)
@@ -0,0 +1,84 @@
+package iavl
+
+import (
+	"io"
+
+	"github.com/tendermint/iavl"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = immutableStore{}
+
+// immutableStore is a read-only KVStore over a persisted version of the tree.
+// Writes panic; cache wrap the store to stage changes that are never written.
+type immutableStore struct {
+	tree *iavl.ImmutableTree
+}
+
+// GetImmutable returns a read-only store of the given version of the tree. It
+// returns an error if the version was never committed or has been released by
+// the pruning options, so that it is not deleted in the background while the
+// store is in use.
+func (st *Store) GetImmutable(version int64) (types.KVStore, error) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
+	if st.released(version) {
+		return nil, cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "")
+	}
+	tree, err := st.tree.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+	return immutableStore{tree}, nil
+}
+
+// Implements Store.
+func (immutableStore) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// Implements Store.
+func (st immutableStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st immutableStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Implements types.KVStore.
+func (st immutableStore) Get(key []byte) (value []byte) {
+	_, value = st.tree.Get(key)
+	return
+}
+
+// Implements types.KVStore.
+func (st immutableStore) Has(key []byte) (exists bool) {
+	return st.tree.Has(key)
+}
+
+// Implements types.KVStore.
+func (immutableStore) Set(_, _ []byte) {
+	panic("cannot write to an immutable IAVL store")
+}
+
+// Implements types.KVStore.
+func (immutableStore) Delete(_ []byte) {
+	panic("cannot delete from an immutable IAVL store")
+}
+
+// Implements types.KVStore.
+func (st immutableStore) Iterator(start, end []byte) types.Iterator {
+	return newIAVLIterator(st.tree, start, end, true)
+}
+
+// Implements types.KVStore.
+func (st immutableStore) ReverseIterator(start, end []byte) types.Iterator {
+	return newIAVLIterator(st.tree, start, end, false)
+}
@@ -0,0 +1,170 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// the IAVL range proof operation constant value
+const ProofOpIAVLRange = "iavl:range"
+
+var _ merkle.ProofOperator = RangeOp{}
+
+// RangeOp takes the KV pairs returned by a subspace query as argument and
+// produces the root hash. Its key is the encoded SubspaceQuery.
+//
+// If the produced root hash matches the expected hash, the pairs are the first
+// pairs of the tree in the range of the query, and all of them unless the page
+// is full.
+type RangeOp struct {
+	// Encoded in ProofOp.Key
+	key []byte
+
+	// To encode in ProofOp.Data. Proof is nil for an empty tree.
+	Proof *iavl.RangeProof `json:"proof"`
+}
+
+// NewRangeOp returns a range proof operation for the given encoded subspace
+// query.
+func NewRangeOp(key []byte, proof *iavl.RangeProof) RangeOp {
+	return RangeOp{
+		key:   key,
+		Proof: proof,
+	}
+}
+
+// RangeOpDecoder decodes a range proof operation from a merkle proof operation.
+func RangeOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpIAVLRange {
+		return nil, cmn.NewError("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpIAVLRange)
+	}
+
+	var op RangeOp
+	err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &op)
+	if err != nil {
+		return nil, cmn.ErrorWrap(err, "decoding ProofOp.Data into RangeOp")
+	}
+
+	return NewRangeOp(pop.Key, op.Proof), nil
+}
+
+// ProofOp returns the merkle proof operation of the range proof operation.
+func (op RangeOp) ProofOp() merkle.ProofOp {
+	bz := cdc.MustMarshalBinaryLengthPrefixed(op)
+	return merkle.ProofOp{
+		Type: ProofOpIAVLRange,
+		Key:  op.key,
+		Data: bz,
+	}
+}
+
+// String implements the Stringer interface for a range proof operation.
+func (op RangeOp) String() string {
+	return fmt.Sprintf("RangeOp{%v}", op.GetKey())
+}
+
+// GetKey returns the key of the range proof operation.
+func (op RangeOp) GetKey() []byte {
+	return op.key
+}
+
+// Run verifies the encoded KV pairs against the range proof. It returns the
+// root hash of the tree if they are the result of the subspace query or an
+// error otherwise.
+func (op RangeOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, cmn.NewError("Value size is not 1")
+	}
+
+	var query types.SubspaceQuery
+	if err := cdc.UnmarshalBinaryBare(op.key, &query); err != nil {
+		return nil, cmn.ErrorWrap(err, "decoding subspace query")
+	}
+
+	var kvs []types.KVPair
+	if err := cdc.UnmarshalBinaryLengthPrefixed(args[0], &kvs); err != nil {
+		return nil, cmn.ErrorWrap(err, "decoding KV pairs")
+	}
+
+	if op.Proof == nil {
+		// the hash of an empty tree is nil
+		if len(kvs) != 0 {
+			return nil, cmn.NewError("proof is nil but %d KV pairs were returned", len(kvs))
+		}
+		return [][]byte{nil}, nil
+	}
+
+	root := op.Proof.ComputeRootHash()
+	if err := op.Proof.Verify(root); err != nil {
+		return nil, cmn.ErrorWrap(err, "computing root hash")
+	}
+
+	if err := verifyRange(op.Proof, query, kvs); err != nil {
+		return nil, err
+	}
+
+	return [][]byte{root}, nil
+}
+
+// verifyRange checks that the KV pairs are the first pairs of a verified range
+// proof in the range of the query, and that the proof leaves no pair of the
+// range out unless the page is full.
+func verifyRange(proof *iavl.RangeProof, query types.SubspaceQuery, kvs []types.KVPair) error {
+	limit := query.PageSize()
+	if len(kvs) > limit {
+		return cmn.NewError("%d KV pairs exceed the limit of %d", len(kvs), limit)
+	}
+
+	// the leaves of the proof are consecutive, so are the ones in range
+	keys := proof.Keys()
+	var inRange [][]byte
+	for _, key := range keys {
+		if bytes.Compare(key, query.Start) >= 0 && (query.End == nil || bytes.Compare(key, query.End) < 0) {
+			inRange = append(inRange, key)
+		}
+	}
+
+	if len(kvs) > len(inRange) {
+		return cmn.NewError("%d KV pairs but only %d keys in range are proven", len(kvs), len(inRange))
+	}
+	for i, kv := range kvs {
+		if !bytes.Equal(kv.Key, inRange[i]) {
+			return cmn.NewError("KV pair %d has key %X, expected %X", i, kv.Key, inRange[i])
+		}
+		if err := proof.VerifyItem(kv.Key, kv.Value); err != nil {
+			return cmn.ErrorWrap(err, "verifying KV pair %d", i)
+		}
+	}
+
+	// no key of the range comes before the first leaf
+	if bytes.Compare(query.Start, keys[0]) < 0 {
+		if err := proof.VerifyAbsence(query.Start); err != nil {
+			return cmn.ErrorWrap(err, "verifying the start of the range")
+		}
+	}
+
+	if len(kvs) == limit {
+		return nil
+	}
+	if len(kvs) < len(inRange) {
+		return cmn.NewError("%d KV pairs but %d keys in range are proven", len(kvs), len(inRange))
+	}
+
+	// no key of the range comes after the last leaf: either a leaf is past
+	// the range or the last leaf is the last one of the tree
+	last := keys[len(keys)-1]
+	if query.End != nil && bytes.Compare(last, query.End) >= 0 {
+		return nil
+	}
+	if err := proof.VerifyAbsence(append(types.Cp(last), 0)); err != nil {
+		return cmn.ErrorWrap(err, "verifying the end of the range")
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// number of nodes written to the database per batch when importing
+const snapshotImportBatchSize = 10000
+
+// key formats of the IAVL node database, see iavl/nodedb.go
+var (
+	nodeKeyFormat = iavl.NewKeyFormat('n', tmhash.Size) // n<hash>
+	rootKeyFormat = iavl.NewKeyFormat('r', 8)           // r<version>
+)
+
+// SnapshotNode is a raw IAVL node record, as persisted in the node database
+// under its hash.
+type SnapshotNode struct {
+	Hash []byte `json:"hash"`
+	Node []byte `json:"node"`
+}
+
+// ExportSnapshot walks the tree persisted in db at the given version, calling
+// fn for every node reachable from its root, parents before children. It
+// returns the root hash of the version, which is empty for an empty tree.
+func ExportSnapshot(db dbm.DB, version int64, fn func(SnapshotNode) error) ([]byte, error) {
+	root := db.Get(rootKeyFormat.Key(version))
+	if root == nil {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+	if len(root) == 0 {
+		return root, nil
+	}
+
+	stack := [][]byte{root}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		bz := db.Get(nodeKeyFormat.Key(hash))
+		if bz == nil {
+			return nil, fmt.Errorf("node %X of version %d not found", hash, version)
+		}
+		node, err := decodeSnapshotNode(bz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode node %X: %v", hash, err)
+		}
+		if err := fn(SnapshotNode{Hash: hash, Node: bz}); err != nil {
+			return nil, err
+		}
+		if !node.isLeaf() {
+			// push the right child first so the left subtree is visited first
+			stack = append(stack, node.rightHash, node.leftHash)
+		}
+	}
+	return root, nil
+}
+
+// SnapshotImporter writes raw IAVL node records into an empty node database.
+// Every node must be referenced by the root or by a previously imported node,
+// and must hash to the hash it is imported under, so a completed import holds
+// exactly the tree with the expected root hash.
+type SnapshotImporter struct {
+	db      dbm.DB
+	batch   dbm.Batch
+	size    int
+	version int64
+	root    []byte
+	pending map[string]bool // hashes referenced but not imported yet
+}
+
+// NewSnapshotImporter creates an importer for the tree with the given root
+// hash at the given version.
+func NewSnapshotImporter(db dbm.DB, version int64, root []byte) *SnapshotImporter {
+	pending := make(map[string]bool)
+	if len(root) > 0 {
+		pending[string(root)] = true
+	} else {
+		root = []byte{} // the root record of an empty tree
+	}
+	return &SnapshotImporter{
+		db:      db,
+		batch:   db.NewBatch(),
+		version: version,
+		root:    root,
+		pending: pending,
+	}
+}
+
+// Add verifies and imports a single node.
+func (im *SnapshotImporter) Add(sn SnapshotNode) error {
+	if !im.pending[string(sn.Hash)] {
+		return fmt.Errorf("unexpected node %X", sn.Hash)
+	}
+	node, err := decodeSnapshotNode(sn.Node)
+	if err != nil {
+		return fmt.Errorf("failed to decode node %X: %v", sn.Hash, err)
+	}
+	if node.version > im.version {
+		return fmt.Errorf("node %X has version %d above %d", sn.Hash, node.version, im.version)
+	}
+	if hash := node.hash(); !bytes.Equal(hash, sn.Hash) {
+		return fmt.Errorf("node hash mismatch: expected %X, got %X", sn.Hash, hash)
+	}
+
+	delete(im.pending, string(sn.Hash))
+	if !node.isLeaf() {
+		im.pending[string(node.leftHash)] = true
+		im.pending[string(node.rightHash)] = true
+	}
+
+	im.batch.Set(nodeKeyFormat.Key(sn.Hash), sn.Node)
+	im.size++
+	if im.size >= snapshotImportBatchSize {
+		im.batch.Write()
+		im.batch = im.db.NewBatch()
+		im.size = 0
+	}
+	return nil
+}
+
+// Commit checks that the whole tree has been imported and saves its root,
+// after which the version can be loaded.
+func (im *SnapshotImporter) Commit() error {
+	if len(im.pending) > 0 {
+		return fmt.Errorf("incomplete tree: %d nodes missing", len(im.pending))
+	}
+	im.batch.Set(rootKeyFormat.Key(im.version), im.root)
+	im.batch.WriteSync()
+	return nil
+}
+
+// snapshotNode holds the fields of a raw node record needed to verify it and
+// to find its children, see Node.writeBytes in iavl/node.go.
+type snapshotNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+func decodeSnapshotNode(bz []byte) (node snapshotNode, err error) {
+	var n int
+	if node.height, n, err = amino.DecodeInt8(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+	if node.size, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+	if node.version, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+	if node.key, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, err
+	}
+	bz = bz[n:]
+
+	if node.isLeaf() {
+		if node.value, n, err = amino.DecodeByteSlice(bz); err != nil {
+			return node, err
+		}
+		bz = bz[n:]
+	} else {
+		if node.leftHash, n, err = amino.DecodeByteSlice(bz); err != nil {
+			return node, err
+		}
+		bz = bz[n:]
+		if node.rightHash, n, err = amino.DecodeByteSlice(bz); err != nil {
+			return node, err
+		}
+		bz = bz[n:]
+		if len(node.leftHash) == 0 || len(node.rightHash) == 0 {
+			return node, fmt.Errorf("inner node without child hashes")
+		}
+	}
+
+	if len(bz) > 0 {
+		return node, fmt.Errorf("%d trailing bytes", len(bz))
+	}
+	return node, nil
+}
+
+func (node snapshotNode) isLeaf() bool {
+	return node.height == 0
+}
+
+// hash computes the node hash, see Node.writeHashBytes in iavl/node.go.
+func (node snapshotNode) hash() []byte {
+	var buf bytes.Buffer
+	// writes to a bytes.Buffer do not fail
+	_ = amino.EncodeInt8(&buf, node.height)
+	_ = amino.EncodeVarint(&buf, node.size)
+	_ = amino.EncodeVarint(&buf, node.version)
+	if node.isLeaf() {
+		_ = amino.EncodeByteSlice(&buf, node.key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(node.value))
+	} else {
+		_ = amino.EncodeByteSlice(&buf, node.leftHash)
+		_ = amino.EncodeByteSlice(&buf, node.rightHash)
+	}
+	return tmhash.Sum(buf.Bytes())
+}
@@ -1,6 +1,7 @@
 package iavl
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"sync"
@@ -33,6 +34,28 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions) (type
 	return iavl, nil
 }
 
+// RollbackVersion deletes all versions of the tree persisted in db after the
+// given version, which becomes its latest version, and returns the root hash
+// of that version.
+func RollbackVersion(db dbm.DB, version int64) ([]byte, error) {
+	tree := iavl.NewMutableTree(db, defaultIAVLCacheSize)
+	if _, err := tree.LoadVersionForOverwriting(version); err != nil {
+		return nil, err
+	}
+
+	// the deletion of later versions is not reported, so reload the tree to
+	// check its latest version
+	tree = iavl.NewMutableTree(db, defaultIAVLCacheSize)
+	latest, err := tree.Load()
+	if err != nil {
+		return nil, err
+	}
+	if latest != version {
+		return nil, fmt.Errorf("failed to delete versions after %d, latest version is %d", version, latest)
+	}
+	return tree.Hash(), nil
+}
+
 //----------------------------------------
 
 var _ types.KVStore = (*Store)(nil)
@@ -55,6 +78,28 @@ type Store struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// The number of commits between two prunings. The versions released in
+	// between are deleted together. A value of 0 or 1 prunes at every commit.
+	pruneInterval int64
+
+	// Whether released versions are deleted in a goroutine instead of within
+	// Commit.
+	pruneBackground bool
+
+	// mtx guards the versions of the tree, which may be deleted in the
+	// background, and the pruning progress below.
+	mtx sync.Mutex
+
+	// All released versions up to prunedUpTo have been deleted.
+	prunedUpTo int64
+
+	// The version of the last commit whose released versions have all been
+	// deleted.
+	prunedHeight int64
+
+	// Whether released versions are being deleted.
+	pruning bool
 }
 
 // CONTRACT: tree should be fully loaded.
@@ -71,22 +116,17 @@ func UnsafeNewStore(tree *iavl.MutableTree, numRecent int64, storeEvery int64) *
 // Implements Committer.
 func (st *Store) Commit() types.CommitID {
 	// Save a new version.
+	st.mtx.Lock()
 	hash, version, err := st.tree.SaveVersion()
+	st.mtx.Unlock()
 	if err != nil {
 		// TODO: Do we want to extend Commit to allow returning errors?
 		panic(err)
 	}
 
-	// Release an old version of history, if not a sync waypoint.
-	previous := version - 1
-	if st.numRecent < previous {
-		toRelease := previous - st.numRecent
-		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-			err := st.tree.DeleteVersion(toRelease)
-			if err != nil && err.(cmn.Error).Data() != iavl.ErrVersionDoesNotExist {
-				panic(err)
-			}
-		}
+	// Release old versions of history, if not sync waypoints.
+	if st.pruneInterval <= 1 || version%st.pruneInterval == 0 {
+		st.prune(version)
 	}
 
 	return types.CommitID{
@@ -95,6 +135,80 @@ func (st *Store) Commit() types.CommitID {
 	}
 }
 
+// released returns whether the version has been released by the pruning
+// options as of the latest version, and thus is or will soon be deleted.
+func (st *Store) released(version int64) bool {
+	if st.storeEvery == 1 {
+		return false
+	}
+	if version > st.tree.Version()-1-st.numRecent {
+		return false
+	}
+	return st.storeEvery == 0 || version%st.storeEvery != 0
+}
+
+// prune deletes the versions released as of the given version, unless a
+// background pruning is still running, in which case the next pruning deletes
+// them.
+func (st *Store) prune(version int64) {
+	st.mtx.Lock()
+	if st.pruning {
+		st.mtx.Unlock()
+		return
+	}
+
+	var versions []int64
+	for v := st.prunedUpTo + 1; v < version-st.numRecent; v++ {
+		if st.released(v) && st.tree.VersionExists(v) {
+			versions = append(versions, v)
+		}
+	}
+	st.pruning = true
+	st.mtx.Unlock()
+
+	if st.pruneBackground {
+		go st.deleteVersions(version, versions)
+	} else {
+		st.deleteVersions(version, versions)
+	}
+}
+
+// deleteVersions deletes the given versions, released as of the given
+// version. The lock is held for a single deletion at a time so that commits
+// and queries are not stalled by the whole pruning.
+func (st *Store) deleteVersions(version int64, versions []int64) {
+	for _, v := range versions {
+		st.mtx.Lock()
+		err := st.tree.DeleteVersion(v)
+		st.mtx.Unlock()
+		if err != nil && err.(cmn.Error).Data() != iavl.ErrVersionDoesNotExist {
+			panic(err)
+		}
+	}
+
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	if version-1-st.numRecent > st.prunedUpTo {
+		st.prunedUpTo = version - 1 - st.numRecent
+	}
+	st.prunedHeight = version
+	st.pruning = false
+}
+
+// PruningStatus implements types.PruningReporter.
+func (st *Store) PruningStatus() types.PruningStatus {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	return types.PruningStatus{
+		KeepRecent:   st.numRecent,
+		KeepEvery:    st.storeEvery,
+		Interval:     st.pruneInterval,
+		Background:   st.pruneBackground,
+		PrunedHeight: st.prunedHeight,
+		Running:      st.pruning,
+	}
+}
+
 // Implements Committer.
 func (st *Store) LastCommitID() types.CommitID {
 	return types.CommitID{
@@ -105,13 +219,24 @@ func (st *Store) LastCommitID() types.CommitID {
 
 // Implements Committer.
 func (st *Store) SetPruning(opt types.PruningOptions) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
 	st.numRecent = opt.KeepRecent()
 	st.storeEvery = opt.KeepEvery()
+	st.pruneInterval = opt.Interval()
+	st.pruneBackground = opt.Background()
 }
 
-// VersionExists returns whether or not a given version is stored.
+// VersionExists returns whether or not a given version is stored. Versions
+// released by the pruning options are not, even before they are deleted.
 func (st *Store) VersionExists(version int64) bool {
-	return st.tree.VersionExists(version)
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	return st.versionExists(version)
+}
+
+func (st *Store) versionExists(version int64) bool {
+	return st.tree.VersionExists(version) && !st.released(version)
 }
 
 // Implements Store.
@@ -162,11 +287,11 @@ func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
 }
 
 // Handle gatest the latest height, if height is 0
-func getHeight(tree *iavl.MutableTree, req abci.RequestQuery) int64 {
+func (st *Store) getHeight(req abci.RequestQuery) int64 {
 	height := req.Height
 	if height == 0 {
-		latest := tree.Version()
-		if tree.VersionExists(latest - 1) {
+		latest := st.tree.Version()
+		if st.versionExists(latest - 1) {
 			height = latest - 1
 		} else {
 			height = latest
@@ -183,23 +308,26 @@ func getHeight(tree *iavl.MutableTree, req abci.RequestQuery) int64 {
 // if you care to have the latest data to see a tx results, you must
 // explicitly set the height you want to see
 func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
-	if len(req.Data) == 0 {
-		msg := "Query cannot be zero length"
-		return errors.ErrTxDecode(msg).QueryResult()
-	}
+	// hold the versions of the tree against background pruning
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
 
 	tree := st.tree
 
 	// store the height we chose in the response, with 0 being changed to the
 	// latest height
-	res.Height = getHeight(tree, req)
+	res.Height = st.getHeight(req)
 
 	switch req.Path {
 	case "/key": // get by key
 		key := req.Data // data holds the key bytes
+		if len(key) == 0 {
+			msg := "Query cannot be zero length"
+			return errors.ErrTxDecode(msg).QueryResult()
+		}
 
 		res.Key = key
-		if !st.VersionExists(res.Height) {
+		if !st.versionExists(res.Height) {
 			res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
 			break
 		}
@@ -229,19 +357,33 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 			_, res.Value = tree.GetVersioned(key, res.Height)
 		}
 
-	case "/subspace":
-		var KVs []types.KVPair
+	case "/subspace": // get a page of KV pairs by key range, all of them if empty
+		var query types.SubspaceQuery
+		if err := cdc.UnmarshalBinaryBare(req.Data, &query); err != nil {
+			msg := fmt.Sprintf("invalid subspace query: %v", err)
+			return errors.ErrTxDecode(msg).QueryResult()
+		}
+		if query.End != nil && bytes.Compare(query.Start, query.End) >= 0 {
+			msg := "subspace query start must be before its end"
+			return errors.ErrUnknownRequest(msg).QueryResult()
+		}
 
-		subspace := req.Data
-		res.Key = subspace
+		res.Key = req.Data
+		if !st.versionExists(res.Height) {
+			res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
+			break
+		}
 
-		iterator := types.KVStorePrefixIterator(st, subspace)
-		for ; iterator.Valid(); iterator.Next() {
-			KVs = append(KVs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+		KVs, proof, err := st.getRangeWithProof(query, res.Height)
+		if err != nil {
+			res.Log = err.Error()
+			break
 		}
 
-		iterator.Close()
 		res.Value = cdc.MustMarshalBinaryLengthPrefixed(KVs)
+		if req.Prove {
+			res.Proof = &merkle.Proof{Ops: []merkle.ProofOp{NewRangeOp(req.Data, proof).ProofOp()}}
+		}
 
 	default:
 		msg := fmt.Sprintf("Unexpected Query path: %v", req.Path)
@@ -251,6 +393,31 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	return
 }
 
+// getRangeWithProof returns the KV pairs selected by a subspace query at the
+// given version along with a range proof of them. The pairs are collected
+// first, so that the proof can be built without an end key: the proof then
+// holds the key before the range, the pairs and the key after the last one.
+func (st *Store) getRangeWithProof(query types.SubspaceQuery, version int64) ([]types.KVPair, *iavl.RangeProof, error) {
+	tree, err := st.tree.GetImmutable(version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limit := query.PageSize()
+	KVs := []types.KVPair{}
+	tree.IterateRange(query.Start, query.End, true, func(key, value []byte) bool {
+		KVs = append(KVs, types.KVPair{Key: key, Value: value})
+		return len(KVs) == limit
+	})
+
+	_, _, proof, err := tree.GetRangeWithProof(query.Start, nil, len(KVs)+2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return KVs, proof, nil
+}
+
 //----------------------------------------
 
 // Implements types.Iterator.
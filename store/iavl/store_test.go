@@ -1,8 +1,10 @@
 package iavl
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -414,6 +416,69 @@ func TestIAVLPruneEverything(t *testing.T) {
 	}
 }
 
+func TestIAVLPruningInterval(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, 0, 0)
+	iavlStore.SetPruning(types.NewPruningOptions(3, 5).WithInterval(4))
+
+	for i := int64(1); i <= 12; i++ {
+		nextVersion(iavlStore)
+
+		for v := int64(1); v <= i; v++ {
+			released := v < i-3 && v%5 != 0
+
+			// released versions are gone at once but only deleted every 4 commits
+			require.Equal(t, !released, iavlStore.VersionExists(v), "version %d at %d", v, i)
+			if released && i%4 == 0 {
+				require.False(t, tree.VersionExists(v), "version %d at %d", v, i)
+			}
+		}
+		if v := i - 4; v > 0 && v%5 != 0 && i%4 != 0 {
+			// released by this commit, awaiting deletion
+			require.True(t, tree.VersionExists(v), "version %d at %d", v, i)
+		}
+	}
+
+	status := iavlStore.PruningStatus()
+	require.Equal(t, int64(12), status.PrunedHeight)
+	require.False(t, status.Running)
+
+	// released versions can not be read anymore
+	_, err := iavlStore.GetImmutable(1)
+	require.Error(t, err)
+	_, err = iavlStore.GetImmutable(5)
+	require.NoError(t, err)
+}
+
+func waitPruning(t *testing.T, st *Store) {
+	for i := 0; st.PruningStatus().Running; i++ {
+		require.True(t, i < 500, "pruning did not complete")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestIAVLBackgroundPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, 0, 0)
+	iavlStore.SetPruning(types.NewPruningOptions(2, 0).WithBackground(true))
+
+	for i := 0; i < 20; i++ {
+		nextVersion(iavlStore)
+	}
+
+	// commits during a background pruning leave their versions to the next one
+	waitPruning(t, iavlStore)
+	nextVersion(iavlStore)
+	waitPruning(t, iavlStore)
+	require.Equal(t, int64(21), iavlStore.PruningStatus().PrunedHeight)
+
+	for v := int64(1); v <= 21; v++ {
+		require.Equal(t, v >= 19, tree.VersionExists(v), "version %d", v)
+	}
+}
+
 func TestIAVLStoreQuery(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, cacheSize)
@@ -440,7 +505,8 @@ func TestIAVLStoreQuery(t *testing.T) {
 	cid := iavlStore.Commit()
 	ver := cid.Version
 	query := abci.RequestQuery{Path: "/key", Data: k1, Height: ver}
-	querySub := abci.RequestQuery{Path: "/subspace", Data: ksub, Height: ver}
+	subQuery := types.SubspaceQuery{Start: ksub, End: types.PrefixEndBytes(ksub)}
+	querySub := abci.RequestQuery{Path: "/subspace", Data: cdc.MustMarshalBinaryBare(subQuery), Height: ver}
 
 	// query subspace before anything set
 	qres := iavlStore.Query(querySub)
@@ -469,6 +535,7 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, v1, qres.Value)
 
 	// and for the subspace
+	querySub.Height = cid.Version
 	qres = iavlStore.Query(querySub)
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Equal(t, valExpSub1, qres.Value)
@@ -493,6 +560,7 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Equal(t, v2, qres.Value)
 	// and for the subspace
+	querySub.Height = cid.Version
 	qres = iavlStore.Query(querySub)
 	require.Equal(t, uint32(errors.CodeOK), qres.Code)
 	require.Equal(t, valExpSub2, qres.Value)
@@ -504,6 +572,101 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, v1, qres.Value)
 }
 
+func querySubspaceWithProof(t *testing.T, st *Store, query types.SubspaceQuery) ([]types.KVPair, RangeOp, []byte) {
+	req := abci.RequestQuery{Path: "/subspace", Data: cdc.MustMarshalBinaryBare(query), Prove: true}
+	res := st.Query(req)
+	require.Equal(t, uint32(errors.CodeOK), res.Code, res.Log)
+	require.Len(t, res.Proof.Ops, 1)
+
+	op, err := RangeOpDecoder(res.Proof.Ops[0])
+	require.NoError(t, err)
+
+	var KVs []types.KVPair
+	cdc.MustUnmarshalBinaryLengthPrefixed(res.Value, &KVs)
+	return KVs, op.(RangeOp), res.Value
+}
+
+func verifySubspace(op RangeOp, KVs []types.KVPair, root []byte) error {
+	hashes, err := op.Run([][]byte{cdc.MustMarshalBinaryLengthPrefixed(KVs)})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hashes[0], root) {
+		return fmt.Errorf("root hash mismatch: %X vs %X", hashes[0], root)
+	}
+	return nil
+}
+
+func TestIAVLStoreQuerySubspaceProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, numRecent, storeEvery)
+
+	// an empty tree has no proof
+	iavlStore.Commit()
+	KVs, op, _ := querySubspaceWithProof(t, iavlStore, types.SubspaceQuery{})
+	require.Empty(t, KVs)
+	require.Nil(t, op.Proof)
+	require.NoError(t, verifySubspace(op, KVs, nil))
+	require.Error(t, verifySubspace(op, []types.KVPair{{Key: []byte("a"), Value: []byte("1")}}, nil))
+
+	for _, key := range []string{"a", "b1", "b2", "b3", "c", "d"} {
+		iavlStore.Set([]byte(key), []byte("v"+key))
+	}
+	iavlStore.Commit()
+	root := iavlStore.Commit().Hash
+
+	kvs := func(keys ...string) []types.KVPair {
+		var res []types.KVPair
+		for _, key := range keys {
+			res = append(res, types.KVPair{Key: []byte(key), Value: []byte("v" + key)})
+		}
+		return res
+	}
+
+	cases := []struct {
+		query    types.SubspaceQuery
+		expected []types.KVPair
+	}{
+		{types.SubspaceQuery{Start: []byte("b"), End: []byte("c")}, kvs("b1", "b2", "b3")},
+		{types.SubspaceQuery{Start: []byte("b"), End: []byte("c"), Limit: 2}, kvs("b1", "b2")},
+		{types.SubspaceQuery{Start: []byte("b2\x00"), End: []byte("c"), Limit: 2}, kvs("b3")},
+		{types.SubspaceQuery{Start: []byte("b1"), End: []byte("b3")}, kvs("b1", "b2")},
+		{types.SubspaceQuery{Start: []byte("bb"), End: []byte("bc")}, kvs()},
+		{types.SubspaceQuery{Start: []byte("x")}, kvs()},
+		{types.SubspaceQuery{Start: []byte("c")}, kvs("c", "d")},
+		{types.SubspaceQuery{End: []byte("b2")}, kvs("a", "b1")},
+		{types.SubspaceQuery{}, kvs("a", "b1", "b2", "b3", "c", "d")},
+		{types.SubspaceQuery{Limit: 1}, kvs("a")},
+	}
+
+	for i, tc := range cases {
+		KVs, op, _ := querySubspaceWithProof(t, iavlStore, tc.query)
+		require.Equal(t, tc.expected, KVs, "case %d", i)
+		require.NoError(t, verifySubspace(op, KVs, root), "case %d", i)
+
+		// omitting the last pair or altering a value is detected
+		if len(KVs) > 0 {
+			require.Error(t, verifySubspace(op, KVs[:len(KVs)-1], root), "case %d", i)
+
+			altered := append([]types.KVPair{}, KVs...)
+			altered[0] = types.KVPair{Key: altered[0].Key, Value: []byte("altered")}
+			require.Error(t, verifySubspace(op, altered, root), "case %d", i)
+		}
+	}
+
+	// the proof of a page does not prove a larger range
+	KVs, op, _ = querySubspaceWithProof(t, iavlStore, types.SubspaceQuery{Start: []byte("b"), End: []byte("c"), Limit: 2})
+	query := types.SubspaceQuery{Start: []byte("b"), End: []byte("c")}
+	op = NewRangeOp(cdc.MustMarshalBinaryBare(query), op.Proof)
+	require.Error(t, verifySubspace(op, KVs, root))
+
+	// an empty or inverted range is rejected
+	data := cdc.MustMarshalBinaryBare(types.SubspaceQuery{Start: []byte("c"), End: []byte("b")})
+	res := iavlStore.Query(abci.RequestQuery{Path: "/subspace", Data: data})
+	require.Equal(t, uint32(errors.CodeUnknownRequest), res.Code)
+}
+
 func BenchmarkIAVLIteratorNext(b *testing.B) {
 	db := dbm.NewMemDB()
 	treeSize := 1000
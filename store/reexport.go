@@ -9,6 +9,7 @@ import (
 // nolint
 type (
 	PruningOptions   = types.PruningOptions
+	PruningStatus    = types.PruningStatus
 	Store            = types.Store
 	Committer        = types.Committer
 	CommitStore      = types.CommitStore
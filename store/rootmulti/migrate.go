@@ -0,0 +1,92 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// migrateBatchSize is the number of entries written to the destination
+// database at once during a migration.
+const migrateBatchSize = 10000
+
+// MigrateStoreDB copies the IAVL tree of the named substore of the multistore
+// persisted in db, from the substore's own database from, or from db if from
+// is nil, to the empty database to. It returns the latest committed ID of the
+// substore after checking that the copied tree has its root hash, so that the
+// store can then be mounted with the database to.
+func MigrateStoreDB(db dbm.DB, name string, from, to dbm.DB) (types.CommitID, error) {
+	latest := getLatestVersion(db)
+	cInfo, err := getCommitInfo(db, latest)
+	if err != nil {
+		return types.CommitID{}, err
+	}
+
+	var id types.CommitID
+	found := false
+	for _, si := range cInfo.StoreInfos {
+		if si.Name == name {
+			id, found = si.Core.CommitID, true
+			break
+		}
+	}
+	if !found {
+		return types.CommitID{}, fmt.Errorf("store %s was not committed at version %d", name, latest)
+	}
+
+	src, dst := substoreDB(db, name, from), substoreDB(nil, name, to)
+	if err := checkEmptyDB(dst); err != nil {
+		return types.CommitID{}, err
+	}
+	if err := copyDB(src, dst); err != nil {
+		return types.CommitID{}, err
+	}
+
+	store, err := iavl.LoadStore(dst, id, types.PruneNothing)
+	if err != nil {
+		return types.CommitID{}, fmt.Errorf("failed to load version %d of the copied tree: %v", id.Version, err)
+	}
+	if hash := store.LastCommitID().Hash; !bytes.Equal(hash, id.Hash) {
+		return types.CommitID{}, fmt.Errorf("copied tree has root hash %X, expected %X", hash, id.Hash)
+	}
+
+	return id, nil
+}
+
+func checkEmptyDB(db dbm.DB) error {
+	it := db.Iterator(nil, nil)
+	defer it.Close()
+
+	if it.Valid() {
+		return fmt.Errorf("destination database is not empty")
+	}
+	return nil
+}
+
+// copyDB copies all the entries of src to dst in batches.
+func copyDB(src, dst dbm.DB) error {
+	it := src.Iterator(nil, nil)
+	defer it.Close()
+
+	batch := dst.NewBatch()
+	n := 0
+	for ; it.Valid(); it.Next() {
+		batch.Set(it.Key(), it.Value())
+		n++
+
+		if n%migrateBatchSize == 0 {
+			batch.WriteSync()
+			batch = dst.NewBatch()
+		}
+	}
+	batch.WriteSync()
+
+	if n == 0 {
+		return fmt.Errorf("source database holds no entries of the store")
+	}
+	return nil
+}
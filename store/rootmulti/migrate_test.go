@@ -0,0 +1,56 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestMigrateStoreDB(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+
+	key2 := store.keysByName["store2"]
+	for i := 0; i < 3; i++ {
+		store.getStoreByName("store2").(types.KVStore).Set([]byte("key"), []byte{byte(i)})
+		store.Commit()
+	}
+	expected := store.LastCommitID()
+
+	// copy store2 to its own database
+	storeDB := dbm.NewMemDB()
+	id, err := MigrateStoreDB(db, "store2", nil, storeDB)
+	require.Nil(t, err)
+	require.Equal(t, store.getStoreByName("store2").(types.CommitStore).LastCommitID(), id)
+
+	// remount store2 with its own database
+	store = NewStore(db)
+	store.pruningOpts = types.PruneSyncable
+	store.MountStoreWithDB(types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(key2, types.StoreTypeIAVL, storeDB)
+	store.MountStoreWithDB(types.NewKVStoreKey("store3"), types.StoreTypeIAVL, nil)
+	require.Nil(t, store.LoadLatestVersion())
+	require.Equal(t, expected, store.LastCommitID())
+	require.Equal(t, []byte{2}, store.getStoreByName("store2").(types.KVStore).Get([]byte("key")))
+
+	// copy it again from its own database
+	id, err = MigrateStoreDB(db, "store2", storeDB, dbm.NewMemDB())
+	require.Nil(t, err)
+	require.Equal(t, int64(3), id.Version)
+
+	// the destination must be empty
+	_, err = MigrateStoreDB(db, "store2", nil, storeDB)
+	require.NotNil(t, err)
+
+	// the store must be committed
+	_, err = MigrateStoreDB(db, "store77", nil, dbm.NewMemDB())
+	require.NotNil(t, err)
+
+	// the source must hold the store
+	_, err = MigrateStoreDB(db, "store2", dbm.NewMemDB(), dbm.NewMemDB())
+	require.NotNil(t, err)
+}
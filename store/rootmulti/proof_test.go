@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/cosmos/cosmos-sdk/store/iavl"
@@ -174,3 +175,57 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestVerifyMultiStoreSubspaceQueryProof(t *testing.T) {
+	// Create main tree for testing.
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	store.LoadVersion(0)
+
+	iavlStore := store.GetCommitStore(iavlStoreKey).(*iavl.Store)
+	iavlStore.Set([]byte("MYKEY1"), []byte("MYVALUE1"))
+	iavlStore.Set([]byte("MYKEY2"), []byte("MYVALUE2"))
+	iavlStore.Set([]byte("OTHERKEY"), []byte("OTHERVALUE"))
+	cid := store.Commit()
+
+	// Get Proof
+	query := types.SubspaceQuery{Start: []byte("MYKEY"), End: types.PrefixEndBytes([]byte("MYKEY"))}
+	data := cdc.MustMarshalBinaryBare(query)
+	res := store.Query(abci.RequestQuery{
+		Path:  "/iavlStoreKey/subspace",
+		Data:  data,
+		Prove: true,
+	})
+	require.NotNil(t, res.Proof)
+	require.Len(t, res.Proof.Ops, 2)
+
+	kvs := []types.KVPair{
+		{Key: []byte("MYKEY1"), Value: []byte("MYVALUE1")},
+		{Key: []byte("MYKEY2"), Value: []byte("MYVALUE2")},
+	}
+	require.Equal(t, cdc.MustMarshalBinaryLengthPrefixed(kvs), res.Value)
+
+	keyPath := func(key []byte) string {
+		kp := merkle.KeyPath{}
+		kp = kp.AppendKey([]byte("iavlStoreKey"), merkle.KeyEncodingURL)
+		kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+		return kp.String()
+	}
+
+	// Verify proof.
+	prt := DefaultProofRuntime()
+	err := prt.VerifyValue(res.Proof, cid.Hash, keyPath(data), res.Value)
+	require.Nil(t, err)
+
+	// Verify (bad) proof.
+	err = prt.VerifyValue(res.Proof, cid.Hash, keyPath(data), cdc.MustMarshalBinaryLengthPrefixed(kvs[:1]))
+	require.NotNil(t, err)
+
+	// Verify (bad) proof.
+	query.End = nil
+	err = prt.VerifyValue(res.Proof, cid.Hash, keyPath(cdc.MustMarshalBinaryBare(query)), res.Value)
+	require.NotNil(t, err)
+}
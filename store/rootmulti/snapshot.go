@@ -0,0 +1,169 @@
+package rootmulti
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// maximum size of a single length-prefixed item in a snapshot stream
+const maxSnapshotItemSize = 64 << 20
+
+// A snapshot stream is the length-prefixed commitInfo of the snapshot height,
+// followed by a section of length-prefixed items per IAVL store: an item
+// carrying the store name, then one item per node of the store's tree.
+type snapshotItem struct {
+	Store string
+	Node  iavl.SnapshotNode
+}
+
+// Snapshot writes the state of all IAVL stores committed at the given height
+// to w. Other committed stores can not be restored and are rejected.
+func (rs *Store) Snapshot(height int64, w io.Writer) (types.CommitID, error) {
+	cInfo, err := getCommitInfo(rs.db, height)
+	if err != nil {
+		return types.CommitID{}, err
+	}
+	if err := rs.snapshot(cInfo, w); err != nil {
+		return types.CommitID{}, err
+	}
+	return cInfo.CommitID(), nil
+}
+
+func (rs *Store) snapshot(cInfo commitInfo, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	w = bw
+	if err := writeSnapshotItem(w, cInfo); err != nil {
+		return err
+	}
+
+	storeInfos := make([]storeInfo, len(cInfo.StoreInfos))
+	copy(storeInfos, cInfo.StoreInfos)
+	sort.Slice(storeInfos, func(i, j int) bool { return storeInfos[i].Name < storeInfos[j].Name })
+
+	for _, si := range storeInfos {
+		params, ok := rs.storesParams[rs.keysByName[si.Name]]
+		if !ok {
+			return fmt.Errorf("store %s is not mounted", si.Name)
+		}
+		if params.typ != types.StoreTypeIAVL {
+			return fmt.Errorf("store %s of type %v can not be snapshotted", si.Name, params.typ)
+		}
+
+		if err := writeSnapshotItem(w, snapshotItem{Store: si.Name}); err != nil {
+			return err
+		}
+		root, err := iavl.ExportSnapshot(rs.storeDB(params), si.Core.CommitID.Version, func(node iavl.SnapshotNode) error {
+			return writeSnapshotItem(w, snapshotItem{Node: node})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to snapshot store %s: %v", si.Name, err)
+		}
+		if !bytes.Equal(root, si.Core.CommitID.Hash) {
+			return fmt.Errorf("store %s root hash %X does not match commit hash %X", si.Name, root, si.Core.CommitID.Hash)
+		}
+	}
+	return bw.Flush()
+}
+
+// Restore restores an empty store from a snapshot stream written by Snapshot,
+// and loads the restored version. It fails unless the resulting CommitID
+// equals id.
+func (rs *Store) Restore(id types.CommitID, r io.Reader) error {
+	if latest := getLatestVersion(rs.db); latest != 0 {
+		return fmt.Errorf("can not restore a snapshot into a store at version %d", latest)
+	}
+	r = bufio.NewReader(r)
+
+	var cInfo commitInfo
+	if _, err := cdc.UnmarshalBinaryLengthPrefixedReader(r, &cInfo, maxSnapshotItemSize); err != nil {
+		return fmt.Errorf("failed to read snapshot commit info: %v", err)
+	}
+	if commitID := cInfo.CommitID(); !commitIDsEqual(commitID, id) {
+		return fmt.Errorf("snapshot commit %v does not match expected commit %v", commitID, id)
+	}
+
+	storeInfos := make(map[string]storeInfo)
+	for _, si := range cInfo.StoreInfos {
+		storeInfos[si.Name] = si
+	}
+
+	var importer *iavl.SnapshotImporter
+	var storeName string
+	for {
+		var item snapshotItem
+		_, err := cdc.UnmarshalBinaryLengthPrefixedReader(r, &item, maxSnapshotItemSize)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read snapshot item: %v", err)
+		}
+
+		if item.Store == "" {
+			if importer == nil {
+				return fmt.Errorf("snapshot node outside of a store section")
+			}
+			if err := importer.Add(item.Node); err != nil {
+				return fmt.Errorf("failed to restore store %s: %v", storeName, err)
+			}
+			continue
+		}
+
+		if importer != nil {
+			if err := importer.Commit(); err != nil {
+				return fmt.Errorf("failed to restore store %s: %v", storeName, err)
+			}
+		}
+		si, ok := storeInfos[item.Store]
+		if !ok {
+			return fmt.Errorf("store %s is not part of the snapshot commit", item.Store)
+		}
+		delete(storeInfos, item.Store)
+		params, ok := rs.storesParams[rs.keysByName[item.Store]]
+		if !ok || params.typ != types.StoreTypeIAVL {
+			return fmt.Errorf("store %s is not mounted as an IAVL store", item.Store)
+		}
+		storeName = item.Store
+		importer = iavl.NewSnapshotImporter(rs.storeDB(params), si.Core.CommitID.Version, si.Core.CommitID.Hash)
+	}
+
+	if importer != nil {
+		if err := importer.Commit(); err != nil {
+			return fmt.Errorf("failed to restore store %s: %v", storeName, err)
+		}
+	}
+	if len(storeInfos) > 0 {
+		return fmt.Errorf("snapshot is missing %d of its committed stores", len(storeInfos))
+	}
+
+	batch := rs.db.NewBatch()
+	setCommitInfo(batch, cInfo.Version, cInfo)
+	setLatestVersion(batch, cInfo.Version)
+	batch.WriteSync()
+
+	if err := rs.LoadVersion(cInfo.Version); err != nil {
+		return err
+	}
+	if commitID := rs.LastCommitID(); !commitIDsEqual(commitID, id) {
+		return fmt.Errorf("restored commit %v does not match expected commit %v", commitID, id)
+	}
+	return nil
+}
+
+func writeSnapshotItem(w io.Writer, item interface{}) error {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(item)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
+func commitIDsEqual(a, b types.CommitID) bool {
+	return a.Version == b.Version && bytes.Equal(a.Hash, b.Hash)
+}
@@ -0,0 +1,96 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newSnapshotMultiStore(t *testing.T) *Store {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, store.LoadLatestVersion())
+
+	store1 := store.getStoreByName("store1").(types.KVStore)
+	store2 := store.getStoreByName("store2").(types.KVStore)
+	for i := 0; i < 100; i++ {
+		store1.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	store.Commit()
+	for i := 0; i < 50; i++ {
+		store1.Delete([]byte(fmt.Sprintf("key%03d", i*2)))
+		store2.Set([]byte(fmt.Sprintf("key%03d", i)), []byte("value"))
+	}
+	store.Commit()
+	// store3 stays empty
+	return store
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	source := newSnapshotMultiStore(t)
+	height := source.LastCommitID().Version
+
+	var buf bytes.Buffer
+	id, err := source.Snapshot(height, &buf)
+	require.NoError(t, err)
+	require.Equal(t, source.LastCommitID(), id)
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, target.LoadLatestVersion())
+	require.NoError(t, target.Restore(id, &buf))
+	require.Equal(t, id, target.LastCommitID())
+
+	for _, name := range []string{"store1", "store2", "store3"} {
+		iter := source.getStoreByName(name).(types.KVStore).Iterator(nil, nil)
+		targetStore := target.getStoreByName(name).(types.KVStore)
+		for ; iter.Valid(); iter.Next() {
+			require.Equal(t, iter.Value(), targetStore.Get(iter.Key()))
+		}
+		iter.Close()
+	}
+
+	// both stores keep committing to the same state
+	for _, store := range []*Store{source, target} {
+		store.getStoreByName("store3").(types.KVStore).Set([]byte("key"), []byte("value"))
+		store.getStoreByName("store1").(types.KVStore).Delete([]byte("key001"))
+	}
+	require.Equal(t, source.Commit(), target.Commit())
+}
+
+func TestSnapshotRestoreErrors(t *testing.T) {
+	source := newSnapshotMultiStore(t)
+	height := source.LastCommitID().Version
+
+	var buf bytes.Buffer
+	id, err := source.Snapshot(height, &buf)
+	require.NoError(t, err)
+	snapshot := buf.Bytes()
+
+	_, err = source.Snapshot(height+1, &bytes.Buffer{})
+	require.Error(t, err)
+
+	restore := func(id types.CommitID, snapshot []byte) error {
+		target := newMultiStoreWithMounts(dbm.NewMemDB())
+		require.NoError(t, target.LoadLatestVersion())
+		return target.Restore(id, bytes.NewReader(snapshot))
+	}
+
+	// wrong commit
+	require.Error(t, restore(types.CommitID{Version: id.Version, Hash: []byte("hash")}, snapshot))
+	require.Error(t, restore(types.CommitID{Version: id.Version - 1, Hash: id.Hash}, snapshot))
+
+	// truncated stream
+	require.Error(t, restore(id, snapshot[:len(snapshot)/2]))
+
+	// tampered node value
+	tampered := bytes.Replace(snapshot, []byte("value43"), []byte("value44"), 1)
+	require.NotEqual(t, snapshot, tampered)
+	require.Error(t, restore(id, tampered))
+
+	// store is not empty
+	require.Error(t, source.Restore(id, bytes.NewReader(snapshot)))
+}
@@ -1,9 +1,12 @@
 package rootmulti
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"sync"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
@@ -35,12 +38,17 @@ type Store struct {
 	stores       map[types.StoreKey]types.CommitStore
 	keysByName   map[string]types.StoreKey
 
+	// whether the substores are committed concurrently
+	parallelCommit bool
+
 	traceWriter  io.Writer
 	traceContext types.TraceContext
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
 var _ types.Queryable = (*Store)(nil)
+var _ types.Snapshotter = (*Store)(nil)
+var _ types.Rollbacker = (*Store)(nil)
 
 // nolint
 func NewStore(db dbm.DB) *Store {
@@ -49,9 +57,17 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitStore),
 		keysByName:   make(map[string]types.StoreKey),
+
+		parallelCommit: true,
 	}
 }
 
+// SetParallelCommit sets whether the substores are committed concurrently,
+// which they are by default.
+func (rs *Store) SetParallelCommit(parallel bool) {
+	rs.parallelCommit = parallel
+}
+
 // Implements CommitMultiStore
 func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
@@ -60,6 +76,36 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	}
 }
 
+// PruningStatus implements types.PruningReporter. The pruned height is the
+// lowest one of the substores, and pruning is running if it runs in any.
+func (rs *Store) PruningStatus() types.PruningStatus {
+	status := types.PruningStatus{
+		KeepRecent:   rs.pruningOpts.KeepRecent(),
+		KeepEvery:    rs.pruningOpts.KeepEvery(),
+		Interval:     rs.pruningOpts.Interval(),
+		Background:   rs.pruningOpts.Background(),
+		PrunedHeight: -1,
+	}
+
+	for _, substore := range rs.stores {
+		reporter, ok := substore.(types.PruningReporter)
+		if !ok {
+			continue
+		}
+
+		ss := reporter.PruningStatus()
+		if status.PrunedHeight < 0 || ss.PrunedHeight < status.PrunedHeight {
+			status.PrunedHeight = ss.PrunedHeight
+		}
+		status.Running = status.Running || ss.Running
+	}
+
+	if status.PrunedHeight < 0 {
+		status.PrunedHeight = 0
+	}
+	return status
+}
+
 // Implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -153,6 +199,50 @@ func (rs *Store) LoadVersion(ver int64) error {
 	return nil
 }
 
+// Rollback reverts the store to the given committed height, deleting the
+// state of all substores and the commit info of every later height, and loads
+// it. Substores are reverted first, so a failed rollback can be retried.
+func (rs *Store) Rollback(height int64) error {
+	latest := getLatestVersion(rs.db)
+	if height <= 0 || height >= latest {
+		return fmt.Errorf("can not roll back to height %d, latest height is %d", height, latest)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, height)
+	if err != nil {
+		return err
+	}
+
+	for _, si := range cInfo.StoreInfos {
+		key, ok := rs.keysByName[si.Name]
+		if !ok {
+			return fmt.Errorf("store %s is not mounted", si.Name)
+		}
+		params := rs.storesParams[key]
+		if params.typ != types.StoreTypeIAVL {
+			return fmt.Errorf("store %s of type %v can not be rolled back", si.Name, params.typ)
+		}
+
+		root, err := iavl.RollbackVersion(rs.storeDB(params), si.Core.CommitID.Version)
+		if err != nil {
+			return fmt.Errorf("failed to roll back store %s: %v", si.Name, err)
+		}
+		if !bytes.Equal(root, si.Core.CommitID.Hash) {
+			return fmt.Errorf("store %s root hash %X does not match commit hash %X", si.Name, root, si.Core.CommitID.Hash)
+		}
+	}
+
+	// Need to update atomically.
+	batch := rs.db.NewBatch()
+	for ver := height + 1; ver <= latest; ver++ {
+		batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, ver)))
+	}
+	setLatestVersion(batch, height)
+	batch.Write()
+
+	return rs.LoadVersion(height)
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
@@ -194,7 +284,7 @@ func (rs *Store) Commit() types.CommitID {
 
 	// Commit stores.
 	version := rs.lastCommitID.Version + 1
-	commitInfo := commitStores(version, rs.stores)
+	commitInfo := commitStores(version, rs.stores, rs.parallelCommit)
 
 	// Need to update atomically.
 	batch := rs.db.NewBatch()
@@ -233,6 +323,42 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
 }
 
+// CacheMultiStoreWithVersion implements CommitMultiStore. The IAVL substores
+// of the returned store are read-only views of the given committed version,
+// substores mounted after that version are empty and all other substores are
+// the current ones.
+func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil {
+		return nil, fmt.Errorf("version %d was not committed: %v", version, err)
+	}
+
+	infos := make(map[string]storeInfo)
+	for _, si := range cInfo.StoreInfos {
+		infos[si.Name] = si
+	}
+
+	stores := make(map[types.StoreKey]types.CacheWrapper)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			stores[key] = store
+			continue
+		}
+
+		si, ok := infos[key.Name()]
+		if !ok {
+			stores[key] = dbadapter.Store{DB: dbm.NewMemDB()}
+			continue
+		}
+
+		stores[key], err = store.(*iavl.Store).GetImmutable(si.Core.CommitID.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load version %d of store %s: %v", version, key.Name(), err)
+		}
+	}
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+}
+
 // Implements MultiStore.
 // If the store does not exist, panics.
 func (rs *Store) GetStore(key types.StoreKey) types.Store {
@@ -347,12 +473,7 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 //----------------------------------------
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
-	var db dbm.DB
-	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		db = dbm.NewPrefixDB(rs.db, []byte("s/k:"+params.key.Name()+"/"))
-	}
+	db := rs.storeDB(params)
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -377,6 +498,20 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	}
 }
 
+// Returns the database backing the substore with the given params.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
+	return substoreDB(rs.db, params.key.Name(), params.db)
+}
+
+// substoreDB returns the database backing the named substore: its own db if
+// it was mounted with one, or else its prefix of the multistore db.
+func substoreDB(db dbm.DB, name string, storeDB dbm.DB) dbm.DB {
+	if storeDB != nil {
+		return dbm.NewPrefixDB(storeDB, []byte("s/_/"))
+	}
+	return dbm.NewPrefixDB(db, []byte("s/k:"+name+"/"))
+}
+
 func (rs *Store) nameToKey(name string) types.StoreKey {
 	for key := range rs.storesParams {
 		if key.Name() == name {
@@ -483,22 +618,53 @@ func setLatestVersion(batch dbm.Batch, version int64) {
 	batch.Set([]byte(latestVersionKey), latestBytes)
 }
 
-// Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitStore) commitInfo {
-	storeInfos := make([]storeInfo, 0, len(storeMap))
+// Commits each store, concurrently if parallel is set, and returns a new
+// commitInfo with the stores sorted by name.
+func commitStores(version int64, storeMap map[types.StoreKey]types.CommitStore, parallel bool) commitInfo {
+	keys := make([]types.StoreKey, 0, len(storeMap))
+	for key := range storeMap {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	commitIDs := make([]types.CommitID, len(keys))
+	if parallel {
+		// substores are independent, so they can be committed concurrently; a
+		// panicking commit is re-raised once all commits are done
+		panics := make([]interface{}, len(keys))
+
+		var wg sync.WaitGroup
+		wg.Add(len(keys))
+		for i, key := range keys {
+			go func(i int, store types.CommitStore) {
+				defer wg.Done()
+				defer func() { panics[i] = recover() }()
+				commitIDs[i] = store.Commit()
+			}(i, storeMap[key])
+		}
+		wg.Wait()
 
-	for key, store := range storeMap {
-		// Commit
-		commitID := store.Commit()
+		for _, r := range panics {
+			if r != nil {
+				panic(r)
+			}
+		}
+	} else {
+		for i, key := range keys {
+			commitIDs[i] = storeMap[key].Commit()
+		}
+	}
 
-		if store.GetStoreType() == types.StoreTypeTransient {
+	storeInfos := make([]storeInfo, 0, len(keys))
+	for i, key := range keys {
+		if storeMap[key].GetStoreType() == types.StoreTypeTransient {
 			continue
 		}
 
 		// Record CommitID
 		si := storeInfo{}
 		si.Name = key.Name()
-		si.Core.CommitID = commitID
+		si.Core.CommitID = commitIDs[i]
 		// si.Core.StoreType = store.GetStoreType()
 		storeInfos = append(storeInfos, si)
 	}
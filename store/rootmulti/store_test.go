@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -191,6 +192,107 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultistoreParallelCommit(t *testing.T) {
+	parallel := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, parallel.LoadLatestVersion())
+	sequential := newMultiStoreWithMounts(dbm.NewMemDB())
+	sequential.SetParallelCommit(false)
+	require.NoError(t, sequential.LoadLatestVersion())
+
+	// Committing the same writes concurrently or not yields the same commits.
+	for i := 0; i < 5; i++ {
+		for _, store := range []*Store{parallel, sequential} {
+			for _, name := range []string{"store1", "store2", "store3"} {
+				kv := store.getStoreByName(name).(types.KVStore)
+				for j := 0; j < 100; j++ {
+					kv.Set([]byte(fmt.Sprintf("%s/%d/%d", name, i, j)), []byte{byte(i), byte(j)})
+				}
+			}
+		}
+
+		commitID := parallel.Commit()
+		require.Equal(t, sequential.Commit(), commitID)
+		checkStore(t, parallel, getExpectedCommitID(parallel, int64(i+1)), commitID)
+
+		cInfo, err := getCommitInfo(parallel.db, commitID.Version)
+		require.NoError(t, err)
+		seqInfo, err := getCommitInfo(sequential.db, commitID.Version)
+		require.NoError(t, err)
+		require.Equal(t, seqInfo, cInfo)
+	}
+}
+
+func TestMultistoreRollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	require.NoError(t, store.LoadLatestVersion())
+
+	// Commit a few versions with changing state.
+	nCommits := int64(4)
+	commitIDs := make([]types.CommitID, nCommits+1)
+	for i := int64(1); i <= nCommits; i++ {
+		store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte{byte(i)})
+		commitIDs[i] = store.Commit()
+	}
+
+	// Heights that are not strictly between zero and the latest height are
+	// rejected.
+	require.Error(t, store.Rollback(0))
+	require.Error(t, store.Rollback(nCommits))
+	require.Error(t, store.Rollback(nCommits+1))
+
+	// Roll back to an older height and check the loaded state.
+	ver := nCommits - 2
+	require.NoError(t, store.Rollback(ver))
+	checkStore(t, store, commitIDs[ver], store.LastCommitID())
+	require.Equal(t, []byte{byte(ver)}, store.getStoreByName("store1").(types.KVStore).Get([]byte("key")))
+
+	// The later heights are gone after reloading the store.
+	store = newMultiStoreWithMounts(db)
+	require.NoError(t, store.LoadLatestVersion())
+	checkStore(t, store, commitIDs[ver], store.LastCommitID())
+	require.Error(t, store.LoadVersion(ver+1))
+
+	// Re-executing the next height with different state commits it anew.
+	store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("new"))
+	commitID := store.Commit()
+	require.Equal(t, ver+1, commitID.Version)
+	require.NotEqual(t, commitIDs[ver+1].Hash, commitID.Hash)
+}
+
+func TestCacheMultiStoreWithVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.SetPruning(types.NewPruningOptions(1, 0))
+	require.NoError(t, store.LoadLatestVersion())
+
+	key := []byte("key")
+	nCommits := int64(4)
+	for i := int64(1); i <= nCommits; i++ {
+		store.getStoreByName("store1").(types.KVStore).Set(key, []byte{byte(i)})
+		store.Commit()
+	}
+
+	// Versions that were pruned or never committed can't be loaded.
+	_, err := store.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+	_, err = store.CacheMultiStoreWithVersion(nCommits + 1)
+	require.Error(t, err)
+
+	for ver := nCommits - 1; ver <= nCommits; ver++ {
+		cms, err := store.CacheMultiStoreWithVersion(ver)
+		require.NoError(t, err)
+
+		kvStore := cms.GetKVStore(store.keysByName["store1"])
+		require.Equal(t, []byte{byte(ver)}, kvStore.Get(key))
+
+		// Writes to the cache are never persisted.
+		kvStore.Set(key, []byte("new"))
+		require.Panics(t, cms.Write)
+	}
+	require.Equal(t, []byte{byte(nCommits)}, store.getStoreByName("store1").(types.KVStore).Get(key))
+}
+
 //-----------------------------------------------------------------------
 // utils
 
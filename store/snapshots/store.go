@@ -0,0 +1,264 @@
+/*
+Package snapshots keeps state-sync snapshots of a multistore on disk.
+
+A snapshot is the byte stream written by a types.Snapshotter at some height,
+split into chunks of at most ChunkSize bytes. Each snapshot lives in its own
+directory, named after its height, holding a metadata.json file with the
+SHA-256 hash of every chunk and one file per chunk named after its index.
+Chunks are verified against their hashes whenever a snapshot is read.
+*/
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// ChunkSize is the maximum size of a snapshot chunk
+	ChunkSize = 16 << 20
+
+	metadataFile = "metadata.json"
+)
+
+// Metadata describes a snapshot
+type Metadata struct {
+	Height  int64          `json:"height"`
+	AppHash cmn.HexBytes   `json:"app_hash"`
+	Chunks  []cmn.HexBytes `json:"chunks"` // SHA-256 hash of each chunk
+}
+
+// CommitID returns the commit the snapshot restores to
+func (m Metadata) CommitID() types.CommitID {
+	return types.CommitID{Version: m.Height, Hash: m.AppHash}
+}
+
+// Store keeps snapshots in a directory
+type Store struct {
+	dir       string
+	chunkSize int
+}
+
+// NewStore creates a snapshot store in the given directory, which is created
+// when the first snapshot is saved
+func NewStore(dir string) *Store {
+	return &Store{dir: dir, chunkSize: ChunkSize}
+}
+
+// Create takes a snapshot of the multistore at the given height and saves
+// it, split into chunks
+func (s *Store) Create(snapshotter types.Snapshotter, height int64) (Metadata, error) {
+	meta := Metadata{Height: height}
+	dir := s.path(height)
+	if _, err := os.Stat(dir); err == nil {
+		return meta, fmt.Errorf("snapshot at height %d already exists", height)
+	}
+
+	// write to a temporary directory so an interrupted save leaves no snapshot
+	tmpDir := dir + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return meta, err
+	}
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return meta, err
+	}
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	pr, pw := io.Pipe()
+	commitCh := make(chan types.CommitID, 1)
+	go func() {
+		id, err := snapshotter.Snapshot(height, pw)
+		commitCh <- id
+		pw.CloseWithError(err)
+	}()
+	defer pr.Close() // nolint: errcheck
+
+	buf := make([]byte, s.chunkSize)
+	for {
+		n, err := io.ReadFull(pr, buf)
+		if err == io.EOF {
+			break
+		} else if err != nil && err != io.ErrUnexpectedEOF {
+			return meta, err
+		}
+
+		chunk := buf[:n]
+		hash := sha256.Sum256(chunk)
+		path := filepath.Join(tmpDir, strconv.Itoa(len(meta.Chunks)))
+		if err := ioutil.WriteFile(path, chunk, 0644); err != nil {
+			return meta, err
+		}
+		meta.Chunks = append(meta.Chunks, hash[:])
+	}
+	meta.AppHash = (<-commitCh).Hash
+
+	if err := writeMetadata(tmpDir, meta); err != nil {
+		return meta, err
+	}
+	return meta, os.Rename(tmpDir, dir)
+}
+
+// Restore restores an empty multistore from the snapshot at the given height
+func (s *Store) Restore(snapshotter types.Snapshotter, height int64) (Metadata, error) {
+	meta, err := s.Get(height)
+	if err != nil {
+		return meta, err
+	}
+	r := &chunkReader{dir: s.path(height), chunks: meta.Chunks}
+	return meta, snapshotter.Restore(meta.CommitID(), r)
+}
+
+// Get returns the metadata of the snapshot at the given height
+func (s *Store) Get(height int64) (Metadata, error) {
+	return readMetadata(s.path(height))
+}
+
+// List returns the metadata of all snapshots, ordered by height
+func (s *Store) List() ([]Metadata, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Metadata
+	for _, entry := range entries {
+		height, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		meta, err := s.Get(height)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, meta)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Height < snapshots[j].Height })
+	return snapshots, nil
+}
+
+// Export copies the snapshot at the given height into dir, which must not
+// exist yet
+func (s *Store) Export(height int64, dir string) error {
+	meta, err := s.Get(height)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists", dir)
+	}
+	return copySnapshot(s.path(height), dir, meta)
+}
+
+// Import verifies the snapshot exported into dir and adds it to the store
+func (s *Store) Import(dir string) (Metadata, error) {
+	meta, err := readMetadata(dir)
+	if err != nil {
+		return meta, err
+	}
+	target := s.path(meta.Height)
+	if _, err := os.Stat(target); err == nil {
+		return meta, fmt.Errorf("snapshot at height %d already exists", meta.Height)
+	}
+
+	tmpDir := target + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return meta, err
+	}
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+	if err := copySnapshot(dir, tmpDir, meta); err != nil {
+		return meta, err
+	}
+	return meta, os.Rename(tmpDir, target)
+}
+
+func (s *Store) path(height int64) string {
+	return filepath.Join(s.dir, strconv.FormatInt(height, 10))
+}
+
+//----------------------------------------
+
+// chunkReader reads the chunks of a snapshot in order, verifying each chunk
+// before returning any of its bytes
+type chunkReader struct {
+	dir    string
+	chunks []cmn.HexBytes
+	next   int
+	buf    []byte
+}
+
+func (cr *chunkReader) Read(p []byte) (int, error) {
+	for len(cr.buf) == 0 {
+		if cr.next >= len(cr.chunks) {
+			return 0, io.EOF
+		}
+		chunk, err := readChunk(cr.dir, cr.next, cr.chunks[cr.next])
+		if err != nil {
+			return 0, err
+		}
+		cr.buf = chunk
+		cr.next++
+	}
+	n := copy(p, cr.buf)
+	cr.buf = cr.buf[n:]
+	return n, nil
+}
+
+func readChunk(dir string, index int, hash []byte) ([]byte, error) {
+	chunk, err := ioutil.ReadFile(filepath.Join(dir, strconv.Itoa(index)))
+	if err != nil {
+		return nil, err
+	}
+	if sum := sha256.Sum256(chunk); !bytes.Equal(sum[:], hash) {
+		return nil, fmt.Errorf("chunk %d hash mismatch: expected %X, got %X", index, hash, sum[:])
+	}
+	return chunk, nil
+}
+
+// copies and verifies the chunks and metadata of a snapshot
+func copySnapshot(from, to string, meta Metadata) error {
+	if err := os.MkdirAll(to, 0755); err != nil {
+		return err
+	}
+	for i, hash := range meta.Chunks {
+		chunk, err := readChunk(from, i, hash)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(to, strconv.Itoa(i)), chunk, 0644); err != nil {
+			return err
+		}
+	}
+	return writeMetadata(to, meta)
+}
+
+func readMetadata(dir string) (Metadata, error) {
+	var meta Metadata
+	bz, err := ioutil.ReadFile(filepath.Join(dir, metadataFile))
+	if err != nil {
+		return meta, err
+	}
+	err = json.Unmarshal(bz, &meta)
+	return meta, err
+}
+
+func writeMetadata(dir string, meta Metadata) error {
+	bz, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, metadataFile), bz, 0644)
+}
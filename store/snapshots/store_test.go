@@ -0,0 +1,93 @@
+package snapshots
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCreateExportImportRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key := types.NewKVStoreKey("store1")
+	source := rootmulti.NewStore(dbm.NewMemDB())
+	source.SetPruning(types.PruneNothing)
+	source.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, source.LoadLatestVersion())
+	for i := 0; i < 100; i++ {
+		source.GetKVStore(key).Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		source.Commit()
+	}
+
+	store := NewStore(filepath.Join(dir, "source"))
+	store.chunkSize = 1000
+
+	meta, err := store.Create(source, 50)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), meta.Height)
+	require.True(t, len(meta.Chunks) > 1)
+	_, err = store.Create(source, 50)
+	require.Error(t, err)
+
+	_, err = store.Create(source, 100)
+	require.NoError(t, err)
+	snapshotList, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshotList, 2)
+	require.Equal(t, meta, snapshotList[0])
+	require.Equal(t, int64(100), snapshotList[1].Height)
+
+	exportDir := filepath.Join(dir, "export")
+	require.NoError(t, store.Export(100, exportDir))
+	require.Error(t, store.Export(100, exportDir))
+
+	targetStore := NewStore(filepath.Join(dir, "target"))
+	imported, err := targetStore.Import(exportDir)
+	require.NoError(t, err)
+	require.Equal(t, snapshotList[1], imported)
+
+	target := rootmulti.NewStore(dbm.NewMemDB())
+	target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, target.LoadLatestVersion())
+	_, err = targetStore.Restore(target, 100)
+	require.NoError(t, err)
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	require.Equal(t, []byte("value"), target.GetKVStore(key).Get([]byte("key99")))
+}
+
+func TestCorruptedChunk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key := types.NewKVStoreKey("store1")
+	source := rootmulti.NewStore(dbm.NewMemDB())
+	source.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, source.LoadLatestVersion())
+	source.GetKVStore(key).Set([]byte("key"), []byte("value"))
+	source.Commit()
+
+	store := NewStore(filepath.Join(dir, "source"))
+	_, err = store.Create(source, 1)
+	require.NoError(t, err)
+
+	chunk := filepath.Join(dir, "source", "1", "0")
+	require.NoError(t, ioutil.WriteFile(chunk, []byte("corrupted"), 0644))
+	require.Error(t, store.Export(1, filepath.Join(dir, "export")))
+
+	target := rootmulti.NewStore(dbm.NewMemDB())
+	target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, target.LoadLatestVersion())
+	_, err = store.Restore(target, 1)
+	require.Error(t, err)
+}
@@ -11,6 +11,19 @@ func NewCommitMultiStore(db dbm.DB) types.CommitMultiStore {
 	return rootmulti.NewStore(db)
 }
 
+// MigrateStoreDB copies the IAVL tree of the named store of the multistore
+// persisted in db from its database, or db if nil, to another database and
+// checks its root hash. See rootmulti.MigrateStoreDB.
+func MigrateStoreDB(db dbm.DB, name string, from, to dbm.DB) (types.CommitID, error) {
+	return rootmulti.MigrateStoreDB(db, name, from, to)
+}
+
+// NewPruningOptions returns pruning options keeping the given number of recent
+// states and every keepEvery-th state.
+func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
+	return types.NewPruningOptions(keepRecent, keepEvery)
+}
+
 func NewPruningOptionsFromString(strategy string) (opt PruningOptions) {
 	switch strategy {
 	case "nothing":
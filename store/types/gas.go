@@ -1,6 +1,9 @@
 package types
 
-import "math"
+import (
+	"fmt"
+	"math"
+)
 
 // Gas consumption descriptors.
 const (
@@ -143,15 +146,128 @@ func (g *infiniteGasMeter) IsOutOfGas() bool {
 	return false
 }
 
+// GasTraceEntry is the total gas consumed under a descriptor by the message
+// of the given index, or before any message, e.g. by the ante handler, if the
+// index is negative.
+type GasTraceEntry struct {
+	MsgIndex   int    `json:"msg_index"`
+	Descriptor string `json:"descriptor"`
+	Gas        Gas    `json:"gas"`
+}
+
+// GasTrace itemizes the gas consumed by a transaction, in the order the
+// entries were first consumed.
+type GasTrace []GasTraceEntry
+
+// Total returns the total gas of the trace.
+func (gt GasTrace) Total() Gas {
+	var total Gas
+	for _, e := range gt {
+		total += e.Gas
+	}
+	return total
+}
+
+// MsgGas returns the total gas consumed by the message of the given index.
+func (gt GasTrace) MsgGas(msgIndex int) Gas {
+	var total Gas
+	for _, e := range gt {
+		if e.MsgIndex == msgIndex {
+			total += e.Gas
+		}
+	}
+	return total
+}
+
+// GasTracer records a GasTrace of the gas consumed through a tracing gas
+// meter, attributing it to the message being run.
+type GasTracer struct {
+	msgIndex int
+	trace    GasTrace
+}
+
+// NewGasTracer returns a new GasTracer attributing gas to no message.
+func NewGasTracer() *GasTracer {
+	return &GasTracer{msgIndex: -1}
+}
+
+// SetMsgIndex attributes the gas consumed from now on to the message of the
+// given index.
+func (t *GasTracer) SetMsgIndex(msgIndex int) {
+	t.msgIndex = msgIndex
+}
+
+// Trace returns a copy of the recorded trace.
+func (t *GasTracer) Trace() GasTrace {
+	trace := make(GasTrace, len(t.trace))
+	copy(trace, t.trace)
+	return trace
+}
+
+func (t *GasTracer) add(amount Gas, descriptor string) {
+	for i, e := range t.trace {
+		if e.MsgIndex == t.msgIndex && e.Descriptor == descriptor {
+			t.trace[i].Gas += amount
+			return
+		}
+	}
+	t.trace = append(t.trace, GasTraceEntry{t.msgIndex, descriptor, amount})
+}
+
+type tracingGasMeter struct {
+	GasMeter
+	tracer *GasTracer
+}
+
+// NewTracingGasMeter returns a GasMeter that records the gas consumed from
+// meter with tracer. As only the gas consumed from a single meter is charged,
+// the tracer restarts its trace unless meter already records with it.
+func NewTracingGasMeter(meter GasMeter, tracer *GasTracer) GasMeter {
+	if tm, ok := meter.(*tracingGasMeter); ok && tm.tracer == tracer {
+		return meter
+	}
+
+	tracer.trace = nil
+	return &tracingGasMeter{
+		GasMeter: meter,
+		tracer:   tracer,
+	}
+}
+
+func (g *tracingGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	// record the gas first, as the meter panics when running out of gas
+	g.tracer.add(amount, descriptor)
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
 // GasConfig defines gas cost for each operation on KVStores
 type GasConfig struct {
-	HasCost          Gas
-	DeleteCost       Gas
-	ReadCostFlat     Gas
-	ReadCostPerByte  Gas
-	WriteCostFlat    Gas
-	WriteCostPerByte Gas
-	IterNextCostFlat Gas
+	HasCost          Gas `json:"has_cost"`
+	DeleteCost       Gas `json:"delete_cost"`
+	ReadCostFlat     Gas `json:"read_cost_flat"`
+	ReadCostPerByte  Gas `json:"read_cost_per_byte"`
+	WriteCostFlat    Gas `json:"write_cost_flat"`
+	WriteCostPerByte Gas `json:"write_cost_per_byte"`
+	IterNextCostFlat Gas `json:"iter_next_cost_flat"`
+}
+
+// Validate returns an error if any flat cost of the gas config is zero, which
+// would allow an unbounded number of the operation in a transaction.
+func (cfg GasConfig) Validate() error {
+	switch {
+	case cfg.HasCost == 0:
+		return fmt.Errorf("invalid has cost: %d", cfg.HasCost)
+	case cfg.DeleteCost == 0:
+		return fmt.Errorf("invalid delete cost: %d", cfg.DeleteCost)
+	case cfg.ReadCostFlat == 0:
+		return fmt.Errorf("invalid flat read cost: %d", cfg.ReadCostFlat)
+	case cfg.WriteCostFlat == 0:
+		return fmt.Errorf("invalid flat write cost: %d", cfg.WriteCostFlat)
+	case cfg.IterNextCostFlat == 0:
+		return fmt.Errorf("invalid flat iteration cost: %d", cfg.IterNextCostFlat)
+	default:
+		return nil
+	}
 }
 
 // KVGasConfig returns a default gas config for KVStores.
@@ -172,3 +288,81 @@ func TransientGasConfig() GasConfig {
 	// TODO: define gasconfig for transient stores
 	return KVGasConfig()
 }
+
+// StoreGasConfig overrides the gas config of a single store, identified by the
+// name of its key.
+type StoreGasConfig struct {
+	Store  string    `json:"store"`
+	Config GasConfig `json:"config"`
+}
+
+// GasSchedule defines the gas configs of the KVStores and TransientStores of
+// an application, along with per store overrides, e.g. for cheap scratch
+// stores.
+type GasSchedule struct {
+	KVStore        GasConfig        `json:"kv_store"`
+	TransientStore GasConfig        `json:"transient_store"`
+	StoreOverrides []StoreGasConfig `json:"store_overrides"`
+}
+
+// DefaultGasSchedule returns the gas schedule of the default gas configs
+// without any store overrides.
+func DefaultGasSchedule() GasSchedule {
+	return GasSchedule{
+		KVStore:        KVGasConfig(),
+		TransientStore: TransientGasConfig(),
+	}
+}
+
+// KVStoreGasConfig returns the gas config of the KVStore with the given name.
+func (gs GasSchedule) KVStoreGasConfig(name string) GasConfig {
+	if cfg, ok := gs.override(name); ok {
+		return cfg
+	}
+	return gs.KVStore
+}
+
+// TransientStoreGasConfig returns the gas config of the TransientStore with
+// the given name.
+func (gs GasSchedule) TransientStoreGasConfig(name string) GasConfig {
+	if cfg, ok := gs.override(name); ok {
+		return cfg
+	}
+	return gs.TransientStore
+}
+
+func (gs GasSchedule) override(name string) (GasConfig, bool) {
+	for _, o := range gs.StoreOverrides {
+		if o.Store == name {
+			return o.Config, true
+		}
+	}
+	return GasConfig{}, false
+}
+
+// Validate checks that all gas configs of the schedule are valid and that every
+// store is overridden at most once.
+func (gs GasSchedule) Validate() error {
+	if err := gs.KVStore.Validate(); err != nil {
+		return fmt.Errorf("invalid KVStore gas config: %v", err)
+	}
+	if err := gs.TransientStore.Validate(); err != nil {
+		return fmt.Errorf("invalid TransientStore gas config: %v", err)
+	}
+
+	seen := make(map[string]bool, len(gs.StoreOverrides))
+	for _, o := range gs.StoreOverrides {
+		if o.Store == "" {
+			return fmt.Errorf("gas config override without store name")
+		}
+		if seen[o.Store] {
+			return fmt.Errorf("duplicate gas config override for store %s", o.Store)
+		}
+		seen[o.Store] = true
+
+		if err := o.Config.Validate(); err != nil {
+			return fmt.Errorf("invalid gas config override for store %s: %v", o.Store, err)
+		}
+	}
+	return nil
+}
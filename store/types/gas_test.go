@@ -69,3 +69,70 @@ func TestAddUint64Overflow(t *testing.T) {
 		)
 	}
 }
+
+func TestGasSchedule(t *testing.T) {
+	scratchConfig := KVGasConfig()
+	scratchConfig.WriteCostFlat = 1
+
+	schedule := DefaultGasSchedule()
+	require.NoError(t, schedule.Validate())
+	require.Equal(t, KVGasConfig(), schedule.KVStoreGasConfig("scratch"))
+	require.Equal(t, TransientGasConfig(), schedule.TransientStoreGasConfig("scratch"))
+
+	schedule.StoreOverrides = []StoreGasConfig{{Store: "scratch", Config: scratchConfig}}
+	require.NoError(t, schedule.Validate())
+	require.Equal(t, scratchConfig, schedule.KVStoreGasConfig("scratch"))
+	require.Equal(t, scratchConfig, schedule.TransientStoreGasConfig("scratch"))
+	require.Equal(t, KVGasConfig(), schedule.KVStoreGasConfig("other"))
+
+	// zero flat costs, unnamed and duplicate overrides are invalid
+	invalid := DefaultGasSchedule()
+	invalid.KVStore.ReadCostFlat = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = DefaultGasSchedule()
+	invalid.TransientStore.IterNextCostFlat = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = DefaultGasSchedule()
+	invalid.StoreOverrides = []StoreGasConfig{{Store: "", Config: scratchConfig}}
+	require.Error(t, invalid.Validate())
+
+	invalid.StoreOverrides = []StoreGasConfig{{Store: "scratch", Config: scratchConfig}, {Store: "scratch", Config: scratchConfig}}
+	require.Error(t, invalid.Validate())
+
+	invalid.StoreOverrides = []StoreGasConfig{{Store: "scratch", Config: GasConfig{}}}
+	require.Error(t, invalid.Validate())
+}
+
+func TestTracingGasMeter(t *testing.T) {
+	tracer := NewGasTracer()
+	meter := NewTracingGasMeter(NewGasMeter(100), tracer)
+	require.Equal(t, meter, NewTracingGasMeter(meter, tracer))
+
+	meter.ConsumeGas(10, "read")
+	tracer.SetMsgIndex(0)
+	meter.ConsumeGas(20, "write")
+	meter.ConsumeGas(5, "write")
+	tracer.SetMsgIndex(1)
+	meter.ConsumeGas(30, "write")
+
+	expected := GasTrace{
+		{MsgIndex: -1, Descriptor: "read", Gas: 10},
+		{MsgIndex: 0, Descriptor: "write", Gas: 25},
+		{MsgIndex: 1, Descriptor: "write", Gas: 30},
+	}
+	require.Equal(t, expected, tracer.Trace())
+	require.Equal(t, meter.GasConsumed(), tracer.Trace().Total())
+	require.Equal(t, Gas(25), tracer.Trace().MsgGas(0))
+
+	// gas consumed past the limit is traced
+	require.Panics(t, func() { meter.ConsumeGas(50, "iter") })
+	require.Equal(t, meter.GasConsumed(), tracer.Trace().Total())
+
+	// tracing a new meter restarts the trace
+	meter = NewTracingGasMeter(NewInfiniteGasMeter(), tracer)
+	require.Empty(t, tracer.Trace())
+	meter.ConsumeGas(1, "has")
+	require.Equal(t, GasTrace{{MsgIndex: 1, Descriptor: "has", Gas: 1}}, tracer.Trace())
+}
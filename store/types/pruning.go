@@ -1,16 +1,21 @@
 package types
 
+import "fmt"
+
 // PruningStrategy specifies how old states will be deleted over time where
 // keepRecent can be used with keepEvery to create a pruning "strategy".
 type PruningOptions struct {
 	keepRecent int64
 	keepEvery  int64
+	interval   int64
+	background bool
 }
 
 func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
 	return PruningOptions{
 		keepRecent: keepRecent,
 		keepEvery:  keepEvery,
+		interval:   1,
 	}
 }
 
@@ -24,6 +29,51 @@ func (po PruningOptions) KeepEvery() int64 {
 	return po.keepEvery
 }
 
+// Interval returns the number of commits between two prunings. The states
+// released in between are deleted together.
+func (po PruningOptions) Interval() int64 {
+	return po.interval
+}
+
+// Background returns whether states are deleted in the background instead of
+// within Commit.
+func (po PruningOptions) Background() bool {
+	return po.background
+}
+
+// WithInterval returns a copy of the options pruning every interval commits.
+func (po PruningOptions) WithInterval(interval int64) PruningOptions {
+	po.interval = interval
+	return po
+}
+
+// WithBackground returns a copy of the options deleting states in the
+// background or within Commit.
+func (po PruningOptions) WithBackground(background bool) PruningOptions {
+	po.background = background
+	return po
+}
+
+// Validate returns an error if any of the options is negative or the interval
+// is zero.
+func (po PruningOptions) Validate() error {
+	switch {
+	case po.keepRecent < 0:
+		return fmt.Errorf("pruning keep-recent must not be negative, got %d", po.keepRecent)
+	case po.keepEvery < 0:
+		return fmt.Errorf("pruning keep-every must not be negative, got %d", po.keepEvery)
+	case po.interval < 1:
+		return fmt.Errorf("pruning interval must be positive, got %d", po.interval)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (po PruningOptions) String() string {
+	return fmt.Sprintf("keep-recent=%d keep-every=%d interval=%d background=%t",
+		po.keepRecent, po.keepEvery, po.interval, po.background)
+}
+
 // default pruning strategies
 var (
 	// PruneEverything means all saved states will be deleted, storing only the current state
@@ -33,3 +83,31 @@ var (
 	// PruneSyncable means only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 	PruneSyncable = NewPruningOptions(100, 10000)
 )
+
+// PruningStatus reports the pruning options of a store and the progress of the
+// deletion of its old states.
+type PruningStatus struct {
+	KeepRecent int64 `json:"keep_recent"`
+	KeepEvery  int64 `json:"keep_every"`
+	Interval   int64 `json:"interval"`
+	Background bool  `json:"background"`
+
+	// PrunedHeight is the height of the last commit whose released states
+	// have all been deleted.
+	PrunedHeight int64 `json:"pruned_height"`
+
+	// Running is true while released states are being deleted.
+	Running bool `json:"running"`
+}
+
+func (ps PruningStatus) String() string {
+	return fmt.Sprintf(`Pruning Status:
+  Keep Recent:   %d
+  Keep Every:    %d
+  Interval:      %d
+  Background:    %t
+  Pruned Height: %d
+  Running:       %t`,
+		ps.KeepRecent, ps.KeepEvery, ps.Interval, ps.Background, ps.PrunedHeight, ps.Running,
+	)
+}
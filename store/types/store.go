@@ -35,6 +35,33 @@ type Queryable interface {
 	Query(abci.RequestQuery) abci.ResponseQuery
 }
 
+// Snapshotter allows a CommitMultiStore to export its committed state at a
+// given height as a byte stream, returning the CommitID of that height, and to
+// restore an empty store from such a stream. Restore must verify that the
+// resulting state matches the given CommitID.
+//
+// This is an optional extension to any CommitMultiStore
+type Snapshotter interface {
+	Snapshot(height int64, w io.Writer) (CommitID, error)
+	Restore(id CommitID, r io.Reader) error
+}
+
+// Rollbacker allows a CommitMultiStore to revert its committed state to a
+// previous height, deleting all later heights.
+//
+// This is an optional extension to any CommitMultiStore
+type Rollbacker interface {
+	Rollback(height int64) error
+}
+
+// PruningReporter allows a Committer to report the progress of the pruning of
+// its old states.
+//
+// This is an optional extension to any Committer
+type PruningReporter interface {
+	PruningStatus() PruningStatus
+}
+
 //----------------------------------------
 // MultiStore
 
@@ -95,6 +122,10 @@ type CommitMultiStore interface {
 	// the next commit after loading must be idempotent (return the
 	// same commit id).  Otherwise the behavior is undefined.
 	LoadVersion(ver int64) error
+
+	// Cache wrap the MultiStore as of a committed version, for read-only
+	// use. Returns an error if the version does not exist or was pruned.
+	CacheMultiStoreWithVersion(version int64) (CacheMultiStore, error)
 }
 
 //---------subsp-------------------------------
@@ -267,6 +298,28 @@ func (key *TransientStoreKey) String() string {
 // key-value result for iterator queries
 type KVPair cmn.KVPair
 
+// MaxSubspaceQueryLimit is the maximum number of KV pairs returned by a single
+// subspace query.
+const MaxSubspaceQueryLimit = 1000
+
+// SubspaceQuery is the request data of a subspace query, selecting the first
+// Limit KV pairs with keys in [Start, End). A nil Start or End leaves that side
+// of the range unbounded.
+type SubspaceQuery struct {
+	Start []byte `json:"start"`
+	End   []byte `json:"end"`
+	Limit int    `json:"limit"`
+}
+
+// PageSize returns the maximum number of KV pairs returned for the query. A
+// limit of zero or above MaxSubspaceQueryLimit means MaxSubspaceQueryLimit.
+func (q SubspaceQuery) PageSize() int {
+	if q.Limit <= 0 || q.Limit > MaxSubspaceQueryLimit {
+		return MaxSubspaceQueryLimit
+	}
+	return q.Limit
+}
+
 //----------------------------------------
 
 // TraceContext contains TraceKVStore context data. It will be written with
@@ -164,7 +164,7 @@ func (coins Coins) String() string {
 }
 
 // IsValid asserts the Coins are sorted, have positive amount,
-// and Denom does not contain upper case characters.
+// and have valid denominations.
 func (coins Coins) IsValid() bool {
 	switch len(coins) {
 	case 0:
@@ -182,7 +182,7 @@ func (coins Coins) IsValid() bool {
 
 		lowDenom := coins[0].Denom
 		for _, coin := range coins[1:] {
-			if strings.ToLower(coin.Denom) != coin.Denom {
+			if err := validateDenom(coin.Denom); err != nil {
 				return false
 			}
 			if coin.Denom <= lowDenom {
@@ -539,8 +539,11 @@ func (coins Coins) Sort() Coins {
 // Parsing
 
 var (
-	// Denominations can be 3 ~ 16 characters long.
-	reDnmString = `[a-z][a-z0-9]{2,15}`
+	// Denominations can be 3 ~ 16 lower case characters long, optionally
+	// followed by slash separated path segments, e.g. the IBC voucher
+	// denomination transfer/<chain-id>/<denom>. Path segments may contain
+	// upper case characters, as chain IDs do.
+	reDnmString = `[a-z][a-z0-9]{2,15}(?:/[a-zA-Z0-9][a-zA-Z0-9._-]{0,63})*`
 	reAmt       = `[[:digit:]]+`
 	reDecAmt    = `[[:digit:]]*\.[[:digit:]]+`
 	reSpc       = `[[:space:]]*`
@@ -580,7 +583,7 @@ func ParseCoin(coinStr string) (coin Coin, err error) {
 	}
 
 	if err := validateDenom(denomStr); err != nil {
-		return Coin{}, fmt.Errorf("invalid denom cannot contain upper case characters or spaces: %s", err)
+		return Coin{}, fmt.Errorf("invalid denom cannot contain upper case characters or spaces outside of path segments: %s", err)
 	}
 
 	return NewCoin(denomStr, amount), nil
@@ -303,8 +303,13 @@ func TestCoins(t *testing.T) {
 		{"gas", NewInt(-1)},
 		{"mineral", NewInt(1)},
 	}
+	vouchers := Coins{
+		{"gas", NewInt(1)},
+		{"transfer/test-chain-AbC12x/gas", NewInt(1)},
+	}
 
 	assert.True(t, good.IsValid(), "Coins are valid")
+	assert.True(t, vouchers.IsValid(), "Coins denoms path segments may contain upper case characters")
 	assert.False(t, mixedCase1.IsValid(), "Coins denoms contain upper case characters")
 	assert.False(t, mixedCase2.IsValid(), "First Coins denoms contain upper case characters")
 	assert.False(t, mixedCase3.IsValid(), "Single denom in Coins contains upper case characters")
@@ -382,6 +387,11 @@ func TestParse(t *testing.T) {
 		{"11me coin, 12you coin", false, nil}, // no spaces in coin names
 		{"1.2btc", false, nil},                // amount must be integer
 		{"5foo-bar", false, nil},              // once more, only letters in coin name
+		{"3transfer/chain-1/foo", true, Coins{{"transfer/chain-1/foo", NewInt(3)}}},
+		{"3transfer/test-chain-AbC12x/foo", true, Coins{{"transfer/test-chain-AbC12x/foo", NewInt(3)}}},
+		{"3transfer//foo", false, nil},        // no empty path segments
+		{"3transfer/chain 1/foo", false, nil}, // no spaces in path segments
+		{"3Transfer/chain-1/foo", false, nil}, // no upper case base denomination
 	}
 
 	for tcIndex, tc := range cases {
@@ -52,6 +52,7 @@ func NewContext(ms MultiStore, header abci.Header, isCheckTx bool, logger log.Lo
 	c = c.WithGasMeter(stypes.NewInfiniteGasMeter())
 	c = c.WithMinGasPrices(DecCoins{})
 	c = c.WithConsensusParams(nil)
+	c = c.WithGasSchedule(DefaultGasSchedule())
 	return c
 }
 
@@ -75,14 +76,18 @@ func (c Context) Value(key interface{}) interface{} {
 	return value
 }
 
-// KVStore fetches a KVStore from the MultiStore.
+// KVStore fetches a KVStore from the MultiStore, charging gas according to
+// the gas schedule of the context.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	gasConfig := c.GasSchedule().KVStoreGasConfig(key.Name())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), gasConfig)
 }
 
-// TransientStore fetches a TransientStore from the MultiStore.
+// TransientStore fetches a TransientStore from the MultiStore, charging gas
+// according to the gas schedule of the context.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	gasConfig := c.GasSchedule().TransientStoreGasConfig(key.Name())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), gasConfig)
 }
 
 //----------------------------------------
@@ -146,6 +151,8 @@ const (
 	contextKeyBlockGasMeter
 	contextKeyMinGasPrices
 	contextKeyConsensusParams
+	contextKeyGasSchedule
+	contextKeyGasTracer
 )
 
 func (c Context) MultiStore() MultiStore {
@@ -178,6 +185,14 @@ func (c Context) ConsensusParams() *abci.ConsensusParams {
 	return c.Value(contextKeyConsensusParams).(*abci.ConsensusParams)
 }
 
+func (c Context) GasSchedule() GasSchedule { return c.Value(contextKeyGasSchedule).(GasSchedule) }
+
+// GasTracer returns the tracer of the gas meter, or nil if gas is not traced.
+func (c Context) GasTracer() *GasTracer {
+	tracer, _ := c.Value(contextKeyGasTracer).(*GasTracer)
+	return tracer
+}
+
 func (c Context) WithMultiStore(ms MultiStore) Context {
 	return c.withValue(contextKeyMultiStore, ms)
 }
@@ -215,7 +230,20 @@ func (c Context) WithVoteInfos(VoteInfos []abci.VoteInfo) Context {
 	return c.withValue(contextKeyVoteInfos, VoteInfos)
 }
 
-func (c Context) WithGasMeter(meter GasMeter) Context { return c.withValue(contextKeyGasMeter, meter) }
+// WithGasMeter sets the gas meter, which records its gas with the gas tracer
+// of the context if any.
+func (c Context) WithGasMeter(meter GasMeter) Context {
+	if tracer := c.GasTracer(); tracer != nil {
+		meter = stypes.NewTracingGasMeter(meter, tracer)
+	}
+	return c.withValue(contextKeyGasMeter, meter)
+}
+
+// WithGasTracer sets a tracer recording the gas consumed from the gas meter of
+// the context, including meters set later on.
+func (c Context) WithGasTracer(tracer *GasTracer) Context {
+	return c.withValue(contextKeyGasTracer, tracer).WithGasMeter(c.GasMeter())
+}
 
 func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	return c.withValue(contextKeyBlockGasMeter, meter)
@@ -233,6 +261,10 @@ func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	return c.withValue(contextKeyConsensusParams, params)
 }
 
+func (c Context) WithGasSchedule(schedule GasSchedule) Context {
+	return c.withValue(contextKeyGasSchedule, schedule)
+}
+
 // Cache the multistore and return a new cached context. The cached context is
 // written to the context when writeCache is called.
 func (c Context) CacheContext() (cc Context, writeCache func()) {
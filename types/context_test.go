@@ -89,6 +89,33 @@ func TestCacheContext(t *testing.T) {
 	require.Equal(t, v2, store.Get(k2))
 }
 
+func TestContextGasSchedule(t *testing.T) {
+	key := types.NewKVStoreKey(t.Name())
+	scratchKey := types.NewKVStoreKey("scratch")
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, types.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(scratchKey, types.StoreTypeIAVL, db)
+	cms.LoadLatestVersion()
+	ctx := types.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
+	require.Equal(t, types.DefaultGasSchedule(), ctx.GasSchedule())
+
+	// stores are charged the default config unless overridden
+	schedule := types.DefaultGasSchedule()
+	scratchConfig := schedule.KVStore
+	scratchConfig.ReadCostFlat = 1
+	schedule.StoreOverrides = []types.StoreGasConfig{{Store: scratchKey.Name(), Config: scratchConfig}}
+
+	ctx = ctx.WithGasMeter(types.NewGasMeter(100000)).WithGasSchedule(schedule)
+	ctx.KVStore(key).Get([]byte("key"))
+	require.Equal(t, schedule.KVStore.ReadCostFlat, ctx.GasMeter().GasConsumed())
+
+	ctx = ctx.WithGasMeter(types.NewGasMeter(100000))
+	ctx.KVStore(scratchKey).Get([]byte("key"))
+	require.Equal(t, scratchConfig.ReadCostFlat, ctx.GasMeter().GasConsumed())
+}
+
 func TestLogContext(t *testing.T) {
 	key := types.NewKVStoreKey(t.Name())
 	ctx := defaultContext(key)
@@ -48,9 +48,7 @@ func NewDecCoinFromCoin(coin Coin) DecCoin {
 	if coin.Amount.LT(ZeroInt()) {
 		panic(fmt.Sprintf("negative decimal coin amount: %v\n", coin.Amount))
 	}
-	if strings.ToLower(coin.Denom) != coin.Denom {
-		panic(fmt.Sprintf("denom cannot contain upper case characters: %s\n", coin.Denom))
-	}
+	mustValidateDenom(coin.Denom)
 
 	return DecCoin{
 		Denom:  coin.Denom,
@@ -448,7 +446,7 @@ func (coins DecCoins) IsValid() bool {
 
 		lowDenom := coins[0].Denom
 		for _, coin := range coins[1:] {
-			if strings.ToLower(coin.Denom) != coin.Denom {
+			if err := validateDenom(coin.Denom); err != nil {
 				return false
 			}
 			if coin.Denom <= lowDenom {
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // An Invariant is a function which tests a particular invariant.
 // If the invariant has been broken, it should return an error
 // containing a descriptive message about what happened.
@@ -8,3 +10,29 @@ type Invariant func(ctx Context) error
 
 // group of Invarient
 type Invariants []Invariant
+
+// expected interface for routing invariants
+type InvariantRouter interface {
+	RegisterRoute(moduleName, route string, invar Invariant)
+}
+
+// InvarRoute is an invariant registered under a module name and route
+type InvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      Invariant
+}
+
+// NewInvarRoute creates a new InvarRoute
+func NewInvarRoute(moduleName, route string, invar Invariant) InvarRoute {
+	return InvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	}
+}
+
+// FullRoute returns the route of the invariant prefixed by its module name
+func (i InvarRoute) FullRoute() string {
+	return fmt.Sprintf("%s/%s", i.ModuleName, i.Route)
+}
@@ -0,0 +1,259 @@
+/*
+Package module contains application module patterns and associated "manager"
+functionality. The module pattern has been broken down by:
+ - AppModule, the interface every module exposes to the application
+ - Manager, which holds a collection of AppModules and drives the BaseApp
+   hooks (routes, queriers, genesis, begin/end blockers and invariants) in a
+   configurable order
+
+An application registers each of its modules with a Manager once, instead of
+wiring every keeper's handler, querier, genesis and block functions by hand.
+*/
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppModule is the standard form for an application module
+type AppModule interface {
+	// name of the module, used as key for the genesis state and the ordering
+	Name() string
+
+	// registers
+	RegisterCodec(*codec.Codec)
+	RegisterInvariants(sdk.InvariantRouter)
+
+	// routes; an empty route means the module exposes no handler or querier
+	Route() string
+	NewHandler() sdk.Handler
+	QuerierRoute() string
+	NewQuerierHandler() sdk.Querier
+
+	// genesis
+	DefaultGenesis() json.RawMessage
+	ValidateGenesis(json.RawMessage) error
+	InitGenesis(sdk.Context, json.RawMessage) []abci.ValidatorUpdate
+	ExportGenesis(sdk.Context) json.RawMessage
+
+	// ABCI
+	BeginBlock(sdk.Context, abci.RequestBeginBlock) sdk.Tags
+	EndBlock(sdk.Context, abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags)
+}
+
+//____________________________________________________________________________
+
+// Manager defines a module manager that provides the high level utility for
+// managing and executing operations for a group of modules
+type Manager struct {
+	Modules            map[string]AppModule
+	OrderInitGenesis   []string
+	OrderExportGenesis []string
+	OrderBeginBlockers []string
+	OrderEndBlockers   []string
+}
+
+// NewManager creates a new Manager object. All orderings default to the order
+// in which the modules are passed, and any ordering set later must list every
+// module.
+func NewManager(modules ...AppModule) *Manager {
+	moduleMap := make(map[string]AppModule)
+	var modulesStr []string
+	for _, module := range modules {
+		if _, ok := moduleMap[module.Name()]; ok {
+			panic(fmt.Sprintf("module %s has already been registered", module.Name()))
+		}
+		moduleMap[module.Name()] = module
+		modulesStr = append(modulesStr, module.Name())
+	}
+
+	return &Manager{
+		Modules:            moduleMap,
+		OrderInitGenesis:   modulesStr,
+		OrderExportGenesis: modulesStr,
+		OrderBeginBlockers: modulesStr,
+		OrderEndBlockers:   modulesStr,
+	}
+}
+
+// SetOrderInitGenesis sets the order of init genesis calls
+func (m *Manager) SetOrderInitGenesis(moduleNames ...string) {
+	m.assertOrderComplete(moduleNames)
+	m.OrderInitGenesis = moduleNames
+}
+
+// SetOrderExportGenesis sets the order of export genesis calls
+func (m *Manager) SetOrderExportGenesis(moduleNames ...string) {
+	m.assertOrderComplete(moduleNames)
+	m.OrderExportGenesis = moduleNames
+}
+
+// SetOrderBeginBlockers sets the order of begin-blocker calls
+func (m *Manager) SetOrderBeginBlockers(moduleNames ...string) {
+	m.assertOrderComplete(moduleNames)
+	m.OrderBeginBlockers = moduleNames
+}
+
+// SetOrderEndBlockers sets the order of end-blocker calls
+func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
+	m.assertOrderComplete(moduleNames)
+	m.OrderEndBlockers = moduleNames
+}
+
+// assertOrderComplete panics unless the ordering lists every registered module
+// exactly once, so that no module is silently left out of a hook
+func (m *Manager) assertOrderComplete(moduleNames []string) {
+	listed := make(map[string]bool)
+	for _, moduleName := range moduleNames {
+		if _, ok := m.Modules[moduleName]; !ok {
+			panic(fmt.Sprintf("module %s is not registered with the manager", moduleName))
+		}
+		if listed[moduleName] {
+			panic(fmt.Sprintf("module %s is listed more than once", moduleName))
+		}
+		listed[moduleName] = true
+	}
+
+	for _, moduleName := range m.moduleNames() {
+		if !listed[moduleName] {
+			panic(fmt.Sprintf("module %s is missing from the ordering", moduleName))
+		}
+	}
+}
+
+// moduleNames returns the names of all registered modules in sorted order, so
+// that registrations which do not follow a configured ordering stay
+// deterministic and never skip a module
+func (m *Manager) moduleNames() []string {
+	names := make([]string, 0, len(m.Modules))
+	for moduleName := range m.Modules {
+		names = append(names, moduleName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterCodec registers the codec of all modules
+func (m *Manager) RegisterCodec(cdc *codec.Codec) {
+	for _, moduleName := range m.moduleNames() {
+		m.Modules[moduleName].RegisterCodec(cdc)
+	}
+}
+
+// RegisterInvariants registers all module invariants
+func (m *Manager) RegisterInvariants(invarRouter sdk.InvariantRouter) {
+	for _, moduleName := range m.moduleNames() {
+		m.Modules[moduleName].RegisterInvariants(invarRouter)
+	}
+}
+
+// RegisterRoutes registers all module message routes and querier routes
+func (m *Manager) RegisterRoutes(router baseapp.Router, queryRouter baseapp.QueryRouter) {
+	for _, moduleName := range m.moduleNames() {
+		module := m.Modules[moduleName]
+		if module.Route() != "" {
+			router.AddRoute(module.Route(), module.NewHandler())
+		}
+		if module.QuerierRoute() != "" {
+			queryRouter.AddRoute(module.QuerierRoute(), module.NewQuerierHandler())
+		}
+	}
+}
+
+// DefaultGenesis returns the default genesis state of all modules, keyed by
+// module name
+func (m *Manager) DefaultGenesis() map[string]json.RawMessage {
+	genesis := make(map[string]json.RawMessage)
+	for moduleName, module := range m.Modules {
+		genesis[moduleName] = module.DefaultGenesis()
+	}
+	return genesis
+}
+
+// ValidateGenesis performs genesis state validation for all modules
+func (m *Manager) ValidateGenesis(genesisData map[string]json.RawMessage) error {
+	for _, moduleName := range m.moduleNames() {
+		if err := m.Modules[moduleName].ValidateGenesis(genesisData[moduleName]); err != nil {
+			return fmt.Errorf("invalid %s genesis state: %v", moduleName, err)
+		}
+	}
+	return nil
+}
+
+// InitGenesis performs init genesis functionality for modules. At most one
+// module may return validator updates.
+func (m *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
+	var validatorUpdates []abci.ValidatorUpdate
+	for _, moduleName := range m.OrderInitGenesis {
+		moduleValUpdates := m.Modules[moduleName].InitGenesis(ctx, genesisData[moduleName])
+
+		// use these validator updates if provided, the module manager assumes
+		// only one module will update the validator set
+		if len(moduleValUpdates) > 0 {
+			if len(validatorUpdates) > 0 {
+				panic("validator InitGenesis updates already set by a previous module")
+			}
+			validatorUpdates = moduleValUpdates
+		}
+	}
+
+	return abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}
+}
+
+// ExportGenesis performs export genesis functionality for modules
+func (m *Manager) ExportGenesis(ctx sdk.Context) map[string]json.RawMessage {
+	genesisData := make(map[string]json.RawMessage)
+	for _, moduleName := range m.OrderExportGenesis {
+		genesisData[moduleName] = m.Modules[moduleName].ExportGenesis(ctx)
+	}
+	return genesisData
+}
+
+// BeginBlock performs begin block functionality for all modules, aggregating
+// the tags returned by each of them
+func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	tags := sdk.EmptyTags()
+	for _, moduleName := range m.OrderBeginBlockers {
+		moduleTags := m.Modules[moduleName].BeginBlock(ctx, req)
+		tags = tags.AppendTags(moduleTags)
+	}
+
+	return abci.ResponseBeginBlock{
+		Tags: tags.ToKVPairs(),
+	}
+}
+
+// EndBlock performs end block functionality for all modules. At most one
+// module may return validator updates.
+func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	tags := sdk.EmptyTags()
+	var validatorUpdates []abci.ValidatorUpdate
+	for _, moduleName := range m.OrderEndBlockers {
+		moduleValUpdates, moduleTags := m.Modules[moduleName].EndBlock(ctx, req)
+		tags = tags.AppendTags(moduleTags)
+
+		// use these validator updates if provided, the module manager assumes
+		// only one module will update the validator set
+		if len(moduleValUpdates) > 0 {
+			if len(validatorUpdates) > 0 {
+				panic("validator EndBlock updates already set by a previous module")
+			}
+			validatorUpdates = moduleValUpdates
+		}
+	}
+
+	return abci.ResponseEndBlock{
+		ValidatorUpdates: validatorUpdates,
+		Tags:             tags,
+	}
+}
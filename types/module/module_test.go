@@ -0,0 +1,160 @@
+package module
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testModule records the calls made to it by the manager
+type testModule struct {
+	name     string
+	calls    *[]string
+	valUpdts []abci.ValidatorUpdate
+}
+
+func (tm testModule) record(call string) { *tm.calls = append(*tm.calls, tm.name+"/"+call) }
+
+func (tm testModule) Name() string                             { return tm.name }
+func (tm testModule) RegisterCodec(_ *codec.Codec)             { tm.record("codec") }
+func (tm testModule) RegisterInvariants(_ sdk.InvariantRouter) { tm.record("invariants") }
+func (tm testModule) Route() string                            { return tm.name }
+func (tm testModule) NewHandler() sdk.Handler                  { return testHandler }
+func (tm testModule) QuerierRoute() string                     { return "" }
+func (tm testModule) NewQuerierHandler() sdk.Querier           { return nil }
+func (tm testModule) DefaultGenesis() json.RawMessage          { return json.RawMessage(`"` + tm.name + `"`) }
+
+func (tm testModule) ValidateGenesis(bz json.RawMessage) error {
+	tm.record("validate:" + string(bz))
+	return nil
+}
+
+func (tm testModule) InitGenesis(_ sdk.Context, bz json.RawMessage) []abci.ValidatorUpdate {
+	tm.record("init:" + string(bz))
+	return tm.valUpdts
+}
+
+func (tm testModule) ExportGenesis(_ sdk.Context) json.RawMessage {
+	tm.record("export")
+	return tm.DefaultGenesis()
+}
+
+func (tm testModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	tm.record("begin")
+	return sdk.NewTags("module", tm.name)
+}
+
+func (tm testModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	tm.record("end")
+	return tm.valUpdts, sdk.NewTags("module", tm.name)
+}
+
+func testHandler(_ sdk.Context, _ sdk.Msg) sdk.Result { return sdk.Result{} }
+
+type testInvariantRouter struct{}
+
+func (testInvariantRouter) RegisterRoute(_, _ string, _ sdk.Invariant) {}
+
+func TestManagerOrdering(t *testing.T) {
+	var calls []string
+	valUpdates := []abci.ValidatorUpdate{{Power: 10}}
+	mm := NewManager(
+		testModule{name: "a", calls: &calls},
+		testModule{name: "b", calls: &calls, valUpdts: valUpdates},
+	)
+	require.Equal(t, []string{"a", "b"}, mm.OrderInitGenesis)
+
+	mm.SetOrderBeginBlockers("b", "a")
+	mm.SetOrderEndBlockers("b", "a")
+
+	// orderings must list every module exactly once
+	require.Panics(t, func() { mm.SetOrderInitGenesis("a", "b", "c") })
+	require.Panics(t, func() { mm.SetOrderInitGenesis("b") })
+	require.Panics(t, func() { mm.SetOrderExportGenesis("a", "a") })
+	require.Panics(t, func() { mm.SetOrderBeginBlockers("a") })
+	require.Panics(t, func() { mm.SetOrderEndBlockers() })
+	require.Equal(t, []string{"a", "b"}, mm.OrderInitGenesis)
+
+	res := mm.BeginBlock(sdk.Context{}, abci.RequestBeginBlock{})
+	require.Equal(t, []string{"b/begin", "a/begin"}, calls)
+	require.Len(t, res.Tags, 2)
+	require.Equal(t, "b", string(res.Tags[0].Value))
+
+	calls = nil
+	endRes := mm.EndBlock(sdk.Context{}, abci.RequestEndBlock{})
+	require.Equal(t, []string{"b/end", "a/end"}, calls)
+	require.Equal(t, valUpdates, endRes.ValidatorUpdates)
+}
+
+func TestManagerGenesis(t *testing.T) {
+	var calls []string
+	mm := NewManager(
+		testModule{name: "a", calls: &calls},
+		testModule{name: "b", calls: &calls},
+	)
+	mm.SetOrderInitGenesis("b", "a")
+
+	genesis := mm.DefaultGenesis()
+	require.NoError(t, mm.ValidateGenesis(genesis))
+	require.Equal(t, []string{`a/validate:"a"`, `b/validate:"b"`}, calls)
+
+	calls = nil
+	res := mm.InitGenesis(sdk.Context{}, genesis)
+	require.Equal(t, []string{`b/init:"b"`, `a/init:"a"`}, calls)
+	require.Empty(t, res.Validators)
+
+	require.Equal(t, genesis, mm.ExportGenesis(sdk.Context{}))
+}
+
+func TestManagerRegisterAllModules(t *testing.T) {
+	var calls []string
+	mm := NewManager(
+		testModule{name: "b", calls: &calls},
+		testModule{name: "a", calls: &calls},
+		testModule{name: "c", calls: &calls},
+	)
+	// registrations don't depend on the orderings
+	mm.OrderInitGenesis = []string{"c"}
+
+	mm.RegisterCodec(codec.New())
+	mm.RegisterInvariants(testInvariantRouter{})
+	require.Equal(t, []string{
+		"a/codec", "b/codec", "c/codec",
+		"a/invariants", "b/invariants", "c/invariants",
+	}, calls)
+
+	router := baseapp.NewRouter()
+	mm.RegisterRoutes(router, baseapp.NewQueryRouter())
+	for _, route := range []string{"a", "b", "c"} {
+		require.NotNil(t, router.Route(route))
+	}
+
+	calls = nil
+	require.NoError(t, mm.ValidateGenesis(mm.DefaultGenesis()))
+	require.Equal(t, []string{`a/validate:"a"`, `b/validate:"b"`, `c/validate:"c"`}, calls)
+}
+
+func TestManagerMultipleValidatorUpdates(t *testing.T) {
+	var calls []string
+	valUpdates := []abci.ValidatorUpdate{{Power: 10}}
+	mm := NewManager(
+		testModule{name: "a", calls: &calls, valUpdts: valUpdates},
+		testModule{name: "b", calls: &calls, valUpdts: valUpdates},
+	)
+
+	require.Panics(t, func() { mm.InitGenesis(sdk.Context{}, mm.DefaultGenesis()) })
+	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, abci.RequestEndBlock{}) })
+}
+
+func TestNewManagerDuplicateModule(t *testing.T) {
+	var calls []string
+	require.Panics(t, func() {
+		NewManager(testModule{name: "a", calls: &calls}, testModule{name: "a", calls: &calls})
+	})
+}
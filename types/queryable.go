@@ -1,6 +1,56 @@
 package types
 
-import abci "github.com/tendermint/tendermint/abci/types"
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
 
 // Type for querier functions on keepers to implement to handle custom queries
 type Querier = func(ctx Context, path []string, req abci.RequestQuery) (res []byte, err Error)
+
+// ProvableQuery declares the store keys a custom query reads, so that a light
+// client can fetch their values with Merkle proofs and rebuild the result of
+// the querier from them instead of trusting the node.
+type ProvableQuery struct {
+	// Store is the name of the store holding the keys.
+	Store string
+
+	// Keys returns the keys read by the query with the given request data.
+	Keys func(data []byte) ([][]byte, error)
+
+	// Result rebuilds the querier result from the values of the keys, in the
+	// same order, where the values of missing keys are empty.
+	Result func(data []byte, values [][]byte) ([]byte, Error)
+}
+
+// NewSingleKeyProvableQuery declares a query reading the single key of store
+// returned by key, whose result is the JSON encoding of the value built by
+// result from the request data and the value of the key.
+func NewSingleKeyProvableQuery(
+	cdc *codec.Codec, store string, key func(data []byte) ([]byte, error),
+	result func(data, value []byte) (interface{}, Error),
+) ProvableQuery {
+	return ProvableQuery{
+		Store: store,
+		Keys: func(data []byte) ([][]byte, error) {
+			k, err := key(data)
+			if err != nil {
+				return nil, err
+			}
+			return [][]byte{k}, nil
+		},
+		Result: func(data []byte, values [][]byte) ([]byte, Error) {
+			res, sdkErr := result(data, values[0])
+			if sdkErr != nil {
+				return nil, sdkErr
+			}
+
+			bz, err := codec.MarshalJSONIndent(cdc, res)
+			if err != nil {
+				return nil, ErrInternal(AppendMsgToErr("could not marshal result to JSON", err.Error()))
+			}
+			return bz, nil
+		},
+	}
+}
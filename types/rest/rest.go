@@ -9,13 +9,15 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
-	GasEstimate uint64 `json:"gas_estimate"`
+	GasEstimate uint64       `json:"gas_estimate"`
+	GasTrace    sdk.GasTrace `json:"gas_trace,omitempty"`
 }
 
 // BaseReq defines a structure that can be embedded in other request structures
@@ -130,8 +132,8 @@ func WriteErrorResponse(w http.ResponseWriter, status int, err string) {
 
 // WriteSimulationResponse prepares and writes an HTTP
 // response for transactions simulations.
-func WriteSimulationResponse(w http.ResponseWriter, cdc *codec.Codec, gas uint64) {
-	gasEst := GasEstimateResponse{GasEstimate: gas}
+func WriteSimulationResponse(w http.ResponseWriter, cdc *codec.Codec, gas uint64, gasTrace sdk.GasTrace) {
+	gasEst := GasEstimateResponse{GasEstimate: gas, GasTrace: gasTrace}
 	resp, err := cdc.MarshalJSON(gasEst)
 	if err != nil {
 		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -187,6 +189,23 @@ func ParseFloat64OrReturnBadRequest(w http.ResponseWriter, s string, defaultIfEm
 	return n, true
 }
 
+// ParseQueryHeightOrReturnBadRequest sets the height to query state at on the
+// given context from the optional height URL query parameter of the request.
+func ParseQueryHeightOrReturnBadRequest(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (context.CLIContext, bool) {
+	heightStr := r.FormValue("height")
+	if heightStr == "" {
+		return cliCtx, true
+	}
+
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil || height < 0 {
+		WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("'%s' is not a valid height", heightStr))
+		return cliCtx, false
+	}
+
+	return cliCtx.WithHeight(height), true
+}
+
 // PostProcessResponse performs post processing for a REST response.
 func PostProcessResponse(w http.ResponseWriter, cdc *codec.Codec, response interface{}, indent bool) {
 	var output []byte
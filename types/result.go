@@ -39,6 +39,13 @@ func (res Result) IsOK() bool {
 	return res.Code.IsOK()
 }
 
+// SimulationResponse defines the response of a transaction simulation,
+// containing its result and an itemized trace of the gas it consumed.
+type SimulationResponse struct {
+	Result   Result   `json:"result"`
+	GasTrace GasTrace `json:"gas_trace"`
+}
+
 // ABCIMessageLogs represents a slice of ABCIMessageLog.
 type ABCIMessageLogs []ABCIMessageLog
 
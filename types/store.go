@@ -17,6 +17,10 @@ type (
 	Committer        = types.Committer
 	CommitStore      = types.CommitStore
 	Queryable        = types.Queryable
+	Snapshotter      = types.Snapshotter
+	Rollbacker       = types.Rollbacker
+	PruningReporter  = types.PruningReporter
+	PruningStatus    = types.PruningStatus
 	MultiStore       = types.MultiStore
 	CacheMultiStore  = types.CacheMultiStore
 	CommitMultiStore = types.CommitMultiStore
@@ -98,6 +102,14 @@ func InclusiveEndBytes(inclusiveBytes []byte) (exclusiveBytes []byte) {
 // key-value result for iterator queries
 type KVPair = types.KVPair
 
+// MaxSubspaceQueryLimit is the maximum number of KV pairs returned by a single
+// subspace query.
+const MaxSubspaceQueryLimit = types.MaxSubspaceQueryLimit
+
+// SubspaceQuery is the request data of a subspace query, selecting the first
+// Limit KV pairs with keys in [Start, End).
+type SubspaceQuery = types.SubspaceQuery
+
 //----------------------------------------
 
 // TraceContext contains TraceKVStore context data. It will be written with
@@ -108,9 +120,14 @@ type TraceContext = types.TraceContext
 
 // nolint - reexport
 type (
-	Gas       = types.Gas
-	GasMeter  = types.GasMeter
-	GasConfig = types.GasConfig
+	Gas            = types.Gas
+	GasMeter       = types.GasMeter
+	GasConfig      = types.GasConfig
+	GasSchedule    = types.GasSchedule
+	StoreGasConfig = types.StoreGasConfig
+	GasTraceEntry  = types.GasTraceEntry
+	GasTrace       = types.GasTrace
+	GasTracer      = types.GasTracer
 )
 
 // nolint - reexport
@@ -118,6 +135,16 @@ func NewGasMeter(limit Gas) GasMeter {
 	return types.NewGasMeter(limit)
 }
 
+// nolint - reexport
+func NewGasTracer() *GasTracer {
+	return types.NewGasTracer()
+}
+
+// nolint - reexport
+func DefaultGasSchedule() GasSchedule {
+	return types.DefaultGasSchedule()
+}
+
 // nolint - reexport
 type (
 	ErrorOutOfGas    = types.ErrorOutOfGas
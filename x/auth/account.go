@@ -511,3 +511,278 @@ func (dva *DelayedVestingAccount) GetStartTime() int64 {
 func (dva *DelayedVestingAccount) GetEndTime() int64 {
 	return dva.EndTime
 }
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// Period defines a length of time and the amount of coins that vest at its end.
+type Period struct {
+	Length int64     `json:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount"` // amount of coins vesting at the end of the period
+}
+
+// Periods is a list of vesting periods
+type Periods []Period
+
+// TotalLength returns the sum of the lengths of the periods.
+func (p Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range p {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of the amounts of the periods.
+func (p Periods) TotalAmount() sdk.Coins {
+	var total sdk.Coins
+	for _, period := range p {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
+
+// Validate checks that every period has a positive length and a valid amount,
+// and that the amounts of the periods add up to the original vesting amount.
+func (p Periods) Validate(originalVesting sdk.Coins) error {
+	for i, period := range p {
+		if period.Length <= 0 {
+			return fmt.Errorf("vesting period %d must have a positive length, got %d", i, period.Length)
+		}
+		if !period.Amount.IsValid() {
+			return fmt.Errorf("vesting period %d has an invalid amount %s", i, period.Amount)
+		}
+	}
+
+	total := p.TotalAmount()
+	if diff, hasNeg := total.SafeSub(originalVesting); hasNeg || !diff.IsZero() {
+		return fmt.Errorf("vesting periods add up to %s instead of the original vesting amount %s", total, originalVesting)
+	}
+	return nil
+}
+
+// PeriodicVestingAccount implements the VestingAccount interface. It vests
+// coins in discrete steps: the amount of each period is unlocked once the
+// period, which starts when the previous one ends, has elapsed.
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the coins start to vest
+	VestingPeriods Periods `json:"vesting_periods"` // periods defining the vesting schedule
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount. The end time
+// of the account is the end of its last period. It panics if the periods are
+// invalid or do not add up to the coins of the account.
+func NewPeriodicVestingAccount(
+	baseAcc *BaseAccount, StartTime int64, periods Periods,
+) *PeriodicVestingAccount {
+
+	if err := periods.Validate(baseAcc.Coins); err != nil {
+		panic(err)
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         StartTime + periods.TotalLength(),
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          StartTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  Coins:            %s
+  AccountNumber:    %d
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  VestingPeriods:   %d `,
+		pva.Address, pubkey, pva.Coins, pva.AccountNumber, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, len(pva.VestingPeriods),
+	)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	// add the amount of every period that has elapsed
+	periodEnd := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		periodEnd += period.Length
+		if blockTime.Unix() < periodEnd {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount)
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// periodic vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
+
+// GetVestingPeriods returns the vesting periods of a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetVestingPeriods() Periods {
+	return pva.VestingPeriods
+}
+
+//-----------------------------------------------------------------------------
+// Module Account
+
+// Permissions that can be granted to module accounts
+const (
+	Minter  = "minter"  // allows the module to mint coins into its account
+	Burner  = "burner"  // allows the module to burn coins held by its account
+	Staking = "staking" // allows the module to hold coins delegated by accounts
+)
+
+// FeeCollectorName is the name of the module account that holds the fees
+// collected by the ante handler
+const FeeCollectorName = "fee_collector"
+
+var _ Account = (*ModuleAccount)(nil)
+
+// ModuleAccount defines an account owned by a module rather than by a key
+// pair. Its address is derived from the module name, so nobody holds its
+// private key, and the permissions restrict how the module may use its coins.
+type ModuleAccount struct {
+	*BaseAccount
+
+	Name        string   `json:"name"`        // name of the module
+	Permissions []string `json:"permissions"` // permissions of the module
+}
+
+// NewModuleAddress returns the address of the module account of the given
+// module.
+func NewModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+// NewModuleAccount returns a new ModuleAccount
+func NewModuleAccount(baseAcc *BaseAccount, name string, permissions ...string) *ModuleAccount {
+	return &ModuleAccount{
+		BaseAccount: baseAcc,
+		Name:        name,
+		Permissions: permissions,
+	}
+}
+
+// NewEmptyModuleAccount returns a new ModuleAccount without coins for the
+// given module.
+func NewEmptyModuleAccount(name string, permissions ...string) *ModuleAccount {
+	baseAcc := NewBaseAccountWithAddress(NewModuleAddress(name))
+	return NewModuleAccount(&baseAcc, name, permissions...)
+}
+
+// GetName returns the name of the module owning the account.
+func (ma ModuleAccount) GetName() string {
+	return ma.Name
+}
+
+// GetPermissions returns the permissions of the module account.
+func (ma ModuleAccount) GetPermissions() []string {
+	return ma.Permissions
+}
+
+// HasPermission returns whether the module account has the given permission.
+func (ma ModuleAccount) HasPermission(permission string) bool {
+	for _, perm := range ma.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPubKey returns an error since module accounts cannot sign transactions.
+func (ma *ModuleAccount) SetPubKey(_ crypto.PubKey) error {
+	return errors.New("module accounts cannot have a public key")
+}
+
+// SetSequence returns an error since module accounts cannot sign transactions.
+func (ma *ModuleAccount) SetSequence(_ uint64) error {
+	return errors.New("module accounts cannot have a sequence")
+}
+
+// String implements fmt.Stringer
+func (ma ModuleAccount) String() string {
+	return fmt.Sprintf(`Module Account:
+  Address:       %s
+  Coins:         %s
+  AccountNumber: %d
+  Name:          %s
+  Permissions:   %v`,
+		ma.Address, ma.Coins, ma.AccountNumber, ma.Name, ma.Permissions,
+	)
+}
+
+// ValidatePermissions returns an error if any of the permissions is unknown.
+func ValidatePermissions(permissions ...string) error {
+	for _, perm := range permissions {
+		switch perm {
+		case Minter, Burner, Staking:
+		default:
+			return fmt.Errorf("unknown module account permission %q", perm)
+		}
+	}
+	return nil
+}
@@ -480,3 +480,291 @@ func TestTrackUndelegationDelVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, dva.DelegatedVesting)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 75)}, dva.GetCoins())
 }
+
+func TestPeriodsValidate(t *testing.T) {
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	half := sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}
+
+	tests := []struct {
+		name    string
+		periods Periods
+		expPass bool
+	}{
+		{"valid periods", Periods{{3600, half}, {3600, half}}, true},
+		{"zero length", Periods{{3600, half}, {0, half}}, false},
+		{"negative length", Periods{{7200, half}, {-3600, half}}, false},
+		{"negative amount", Periods{{3600, origCoins}, {3600, sdk.Coins{sdk.Coin{Denom: feeDenom, Amount: sdk.NewInt(-500)}}}}, false},
+		{"unsorted amount", Periods{{3600, sdk.Coins{half[1], half[0]}}, {3600, half}}, false},
+		{"invalid denomination", Periods{{3600, half}, {3600, sdk.Coins{sdk.Coin{Denom: "F", Amount: sdk.NewInt(500)}}}}, false},
+		{"less than the original vesting", Periods{{3600, half}}, false},
+		{"more than the original vesting", Periods{{3600, half}, {3600, origCoins}}, false},
+		{"other denominations", Periods{{3600, sdk.Coins{sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin(stakeDenom, 100)}}}, false},
+	}
+
+	for _, tc := range tests {
+		err := tc.periods.Validate(origCoins)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+
+			_, _, addr := keyPubAddr()
+			bacc := NewBaseAccountWithAddress(addr)
+			bacc.SetCoins(origCoins)
+			require.Panics(t, func() { NewPeriodicVestingAccount(&bacc, 0, tc.periods) }, tc.name)
+		}
+	}
+}
+
+func TestGetVestedCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := keyPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	require.Equal(t, endTime.Unix(), pva.GetEndTime())
+	require.Equal(t, origCoins, periods.TotalAmount())
+
+	// require no coins vested in the very beginning of the vesting schedule
+	vestedCoins := pva.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	vestedCoins = pva.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+
+	// require no coins vested during the first vesting period
+	vestedCoins = pva.GetVestedCoins(now.Add(6 * time.Hour))
+	require.Nil(t, vestedCoins)
+
+	// require 50% of coins vested after the first vesting period
+	vestedCoins = pva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require 50% of coins vested during the second vesting period
+	vestedCoins = pva.GetVestedCoins(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require 75% of coins vested after the second vesting period
+	vestedCoins = pva.GetVestedCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, vestedCoins)
+
+	// require 100% of coins vested
+	vestedCoins = pva.GetVestedCoins(now.Add(48 * time.Hour))
+	require.Equal(t, origCoins, vestedCoins)
+}
+
+func TestGetVestingCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := keyPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+
+	// require all coins vesting in the beginning of the vesting schedule
+	vestingCoins := pva.GetVestingCoins(now)
+	require.Equal(t, origCoins, vestingCoins)
+
+	// require no coins vesting at the end of the vesting schedule
+	vestingCoins = pva.GetVestingCoins(endTime)
+	require.Nil(t, vestingCoins)
+
+	// require 50% of coins vesting
+	vestingCoins = pva.GetVestingCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestingCoins)
+
+	// require 25% of coins vesting
+	vestingCoins = pva.GetVestingCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, vestingCoins)
+}
+
+func TestSpendableCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := keyPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+
+	// require that there exist no spendable coins in the beginning of the
+	// vesting schedule
+	spendableCoins := pva.SpendableCoins(now)
+	require.Nil(t, spendableCoins)
+
+	// require that all original coins are spendable at the end of the vesting
+	// schedule
+	spendableCoins = pva.SpendableCoins(endTime)
+	require.Equal(t, origCoins, spendableCoins)
+
+	// require that all vested coins (50%) are spendable
+	spendableCoins = pva.SpendableCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, spendableCoins)
+
+	// receive some coins
+	recvAmt := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	pva.SetCoins(pva.GetCoins().Add(recvAmt))
+
+	// require that all vested coins (50%) are spendable plus any received
+	spendableCoins = pva.SpendableCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 100)}, spendableCoins)
+
+	// spend all spendable coins
+	pva.SetCoins(pva.GetCoins().Sub(spendableCoins))
+
+	// require that no more coins are spendable
+	spendableCoins = pva.SpendableCoins(now.Add(12 * time.Hour))
+	require.Nil(t, spendableCoins)
+}
+
+func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := keyPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+
+	// require the ability to delegate all vesting coins
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins)
+	require.Equal(t, origCoins, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.GetCoins())
+
+	// require the ability to delegate all vested coins
+	bacc.SetCoins(origCoins)
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(endTime, origCoins)
+	require.Nil(t, pva.DelegatedVesting)
+	require.Equal(t, origCoins, pva.DelegatedFree)
+	require.Nil(t, pva.GetCoins())
+
+	// require the ability to delegate all vesting coins (50%) and all vested coins (50%)
+	bacc.SetCoins(origCoins)
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+
+	pva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, pva.GetCoins())
+
+	// require no modifications when delegation amount is zero or not enough funds
+	bacc.SetCoins(origCoins)
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	require.Panics(t, func() {
+		pva.TrackDelegation(endTime, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
+	})
+	require.Nil(t, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+	require.Equal(t, origCoins, pva.GetCoins())
+}
+
+func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := keyPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+
+	// require the ability to undelegate all vesting coins
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins)
+	pva.TrackUndelegation(origCoins)
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.DelegatedVesting)
+	require.Equal(t, origCoins, pva.GetCoins())
+
+	// require the ability to undelegate all vested coins
+	bacc.SetCoins(origCoins)
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+
+	pva.TrackDelegation(endTime, origCoins)
+	pva.TrackUndelegation(origCoins)
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.DelegatedVesting)
+	require.Equal(t, origCoins, pva.GetCoins())
+
+	// require no modifications when the undelegation amount is zero
+	bacc.SetCoins(origCoins)
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+
+	require.Panics(t, func() {
+		pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
+	})
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.DelegatedVesting)
+	require.Equal(t, origCoins, pva.GetCoins())
+
+	// vest 50% and delegate to two validators
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	pva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+
+	// undelegate from one validator that got slashed 50%
+	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 25)}, pva.GetCoins())
+
+	// undelegate from the other validator that did not get slashed
+	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Nil(t, pva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 75)}, pva.GetCoins())
+}
+
+func TestModuleAccount(t *testing.T) {
+	macc := NewEmptyModuleAccount("test", Minter, Burner)
+	require.Equal(t, NewModuleAddress("test"), macc.GetAddress())
+	require.Equal(t, "test", macc.GetName())
+	require.True(t, macc.HasPermission(Minter))
+	require.True(t, macc.HasPermission(Burner))
+	require.False(t, macc.HasPermission(Staking))
+
+	// module accounts cannot sign transactions
+	_, pub, _ := keyPubAddr()
+	require.Error(t, macc.SetPubKey(pub))
+	require.Error(t, macc.SetSequence(1))
+
+	require.NoError(t, ValidatePermissions(Minter, Burner, Staking))
+	require.Error(t, ValidatePermissions(Minter, "unknown"))
+}
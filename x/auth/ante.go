@@ -29,8 +29,8 @@ func init() {
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
+// signer. The deducted fees are recorded in the supply as held by modules.
+func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper, sk SupplyKeeper) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -56,7 +56,7 @@ func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
 			}
 		}
 
-		newCtx = SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
+		newCtx = SetGasMeter(simulate, ctx, stdTx.Fee.Gas).WithGasSchedule(params.GasSchedule)
 
 		// AnteHandlers must have their own defer/recover in order for the BaseApp
 		// to know how much gas was used! This is because the GasMeter is created in
@@ -109,7 +109,14 @@ func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
 				return newCtx, res, true
 			}
 
+			res = CollectFees(newCtx, ak, stdTx.Fee.Amount)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+
 			fck.AddCollectedFees(newCtx, stdTx.Fee.Amount)
+			sk.DeflateLiquid(newCtx, stdTx.Fee.Amount)
+			sk.InflateModules(newCtx, stdTx.Fee.Amount)
 		}
 
 		// stdSigs contains the sequence number, account number, and signatures.
@@ -337,6 +344,22 @@ func DeductFees(blockTime time.Time, acc Account, fee StdFee) (Account, sdk.Resu
 	return acc, sdk.Result{}
 }
 
+// CollectFees adds the fees deducted from the fee payer to the fee collector
+// module account, creating the account if it does not exist yet.
+func CollectFees(ctx sdk.Context, ak AccountKeeper, fees sdk.Coins) sdk.Result {
+	acc := ak.GetAccount(ctx, NewModuleAddress(FeeCollectorName))
+	if acc == nil {
+		acc = ak.NewAccount(ctx, NewEmptyModuleAccount(FeeCollectorName))
+	}
+
+	if err := acc.SetCoins(acc.GetCoins().Add(fees)); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+
+	ak.SetAccount(ctx, acc)
+	return sdk.Result{}
+}
+
 // EnsureSufficientMempoolFees verifies that the given transaction has supplied
 // enough fees to cover a proposer's minimum fees. A result object is returned
 // indicating success or failure.
@@ -47,7 +47,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 	// setup
 	input := setupTestInput()
 	ctx := input.ctx
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 
 	// keys and addresses
 	priv1, _, addr1 := keyPubAddr()
@@ -95,7 +95,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -150,7 +150,7 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 	ctx := input.ctx.WithBlockHeight(0)
 
 	// keys and addresses
@@ -205,7 +205,7 @@ func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 func TestAnteHandlerSequences(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -280,7 +280,7 @@ func TestAnteHandlerFees(t *testing.T) {
 	// setup
 	input := setupTestInput()
 	ctx := input.ctx
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 
 	// keys and addresses
 	priv1, _, addr1 := keyPubAddr()
@@ -306,6 +306,8 @@ func TestAnteHandlerFees(t *testing.T) {
 
 	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
 	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().AmountOf("atom").Equal(sdk.NewInt(149)))
+	require.True(t, input.sk.liquidDeflated.IsZero())
+	require.True(t, input.sk.modulesInflated.IsZero())
 
 	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
 	input.ak.SetAccount(ctx, acc1)
@@ -313,13 +315,15 @@ func TestAnteHandlerFees(t *testing.T) {
 
 	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
 	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().AmountOf("atom").Equal(sdk.NewInt(0)))
+	require.True(t, input.sk.liquidDeflated.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
+	require.True(t, input.sk.modulesInflated.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
 }
 
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -355,10 +359,44 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+func TestAnteHandlerGasSchedule(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
+	ctx := input.ctx.WithBlockHeight(1)
+
+	// set a gas schedule overriding the gas config of the account store
+	params := input.ak.GetParams(ctx)
+	accConfig := params.GasSchedule.KVStore
+	accConfig.ReadCostFlat = 1
+	params.GasSchedule.StoreOverrides = []sdk.StoreGasConfig{{Store: "authCapKey", Config: accConfig}}
+	input.ak.SetParams(ctx, params)
+
+	// keys and addresses
+	priv1, _, addr1 := keyPubAddr()
+
+	// set the accounts
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	input.ak.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	msg := newTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 0)))
+	tx := newTestTx(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee)
+
+	// the schedule applies to the rest of the transaction
+	newCtx, result, abort := anteHandler(ctx, tx, false)
+	require.False(t, abort)
+	require.True(t, result.IsOK())
+	require.Equal(t, params.GasSchedule, newCtx.GasSchedule())
+	require.Equal(t, accConfig, newCtx.GasSchedule().KVStoreGasConfig("authCapKey"))
+}
+
 func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -405,7 +443,7 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -480,7 +518,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 func TestAnteHandlerSetPubKey(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -674,7 +712,7 @@ func TestCountSubkeys(t *testing.T) {
 func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck)
+	anteHandler := NewAnteHandler(input.ak, input.fck, input.sk)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -0,0 +1,12 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// expected supply keeper, recording the fees moved from accounts to the
+// collected fees
+type SupplyKeeper interface {
+	DeflateLiquid(ctx sdk.Context, amt sdk.Coins)
+	InflateModules(ctx sdk.Context, amt sdk.Coins)
+}
@@ -57,5 +57,8 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.TxSizeCostPerByte == 0 {
 		return fmt.Errorf("invalid tx size cost per byte: %d", data.Params.TxSizeCostPerByte)
 	}
+	if err := data.Params.GasSchedule.Validate(); err != nil {
+		return fmt.Errorf("invalid gas schedule: %v", err)
+	}
 	return nil
 }
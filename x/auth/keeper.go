@@ -11,6 +11,9 @@ import (
 )
 
 const (
+	// ModuleName is the name of the auth module
+	ModuleName = "auth"
+
 	// StoreKey is string representation of the store key for auth
 	StoreKey = "acc"
 
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the auth module.
+type AppModule struct {
+	accountKeeper       AccountKeeper
+	feeCollectionKeeper FeeCollectionKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(accountKeeper AccountKeeper, feeCollectionKeeper FeeCollectionKeeper) AppModule {
+	return AppModule{
+		accountKeeper:       accountKeeper,
+		feeCollectionKeeper: feeCollectionKeeper,
+	}
+}
+
+// Name returns the auth module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the auth module's types for the given codec
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterInvariants registers the auth module invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// Route returns the message routing key for the auth module; auth has no
+// messages of its own
+func (AppModule) Route() string { return "" }
+
+// NewHandler returns an sdk.Handler for the auth module
+func (AppModule) NewHandler() sdk.Handler { return nil }
+
+// QuerierRoute returns the auth module's querier route name
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// NewQuerierHandler returns the auth module sdk.Querier
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.accountKeeper)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the auth
+// module
+func (AppModule) DefaultGenesis() json.RawMessage {
+	return msgCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the auth module
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := msgCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// InitGenesis performs genesis initialization for the auth module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	msgCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.accountKeeper, am.feeCollectionKeeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the auth
+// module
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.accountKeeper, am.feeCollectionKeeper)
+	return msgCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the auth module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the auth module. It returns no validator
+// updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -27,17 +28,19 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyGasSchedule            = []byte("GasSchedule")
 )
 
 var _ params.ParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
-	MaxMemoCharacters      uint64 `json:"max_memo_characters"`
-	TxSigLimit             uint64 `json:"tx_sig_limit"`
-	TxSizeCostPerByte      uint64 `json:"tx_size_cost_per_byte"`
-	SigVerifyCostED25519   uint64 `json:"sig_verify_cost_ed25519"`
-	SigVerifyCostSecp256k1 uint64 `json:"sig_verify_cost_secp256k1"`
+	MaxMemoCharacters      uint64          `json:"max_memo_characters"`
+	TxSigLimit             uint64          `json:"tx_sig_limit"`
+	TxSizeCostPerByte      uint64          `json:"tx_size_cost_per_byte"`
+	SigVerifyCostED25519   uint64          `json:"sig_verify_cost_ed25519"`
+	SigVerifyCostSecp256k1 uint64          `json:"sig_verify_cost_secp256k1"`
+	GasSchedule            sdk.GasSchedule `json:"gas_schedule"`
 }
 
 // ParamKeyTable for auth module
@@ -55,6 +58,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyTxSizeCostPerByte, &p.TxSizeCostPerByte},
 		{KeySigVerifyCostED25519, &p.SigVerifyCostED25519},
 		{KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1},
+		{KeyGasSchedule, &p.GasSchedule},
 	}
 }
 
@@ -73,6 +77,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		GasSchedule:            sdk.DefaultGasSchedule(),
 	}
 }
 
@@ -85,5 +90,6 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("TxSizeCostPerByte: %d\n", p.TxSizeCostPerByte))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostED25519: %d\n", p.SigVerifyCostED25519))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
+	sb.WriteString(fmt.Sprintf("GasSchedule: %+v\n", p.GasSchedule))
 	return sb.String()
 }
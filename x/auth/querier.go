@@ -44,14 +44,58 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper)
 	}
 
 	account := keeper.GetAccount(ctx, params.Address)
+	return accountQueryResult(keeper.cdc, params.Address, account)
+}
+
+func accountQueryResult(cdc *codec.Codec, addr sdk.AccAddress, account Account) ([]byte, sdk.Error) {
 	if account == nil {
-		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", params.Address))
+		return nil, errUnknownAccount(addr)
 	}
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, account)
+	bz, err := codec.MarshalJSONIndent(cdc, account)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
 
 	return bz, nil
 }
+
+func errUnknownAccount(addr sdk.AccAddress) sdk.Error {
+	return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", addr))
+}
+
+// ProvableQueries returns the store keys backing the auth queries by query
+// path, see sdk.ProvableQuery.
+func ProvableQueries(cdc *codec.Codec) map[string]sdk.ProvableQuery {
+	parseParams := func(data []byte) (params QueryAccountParams, err error) {
+		err = cdc.UnmarshalJSON(data, &params)
+		return params, err
+	}
+
+	return map[string]sdk.ProvableQuery{
+		fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryAccount): sdk.NewSingleKeyProvableQuery(cdc, StoreKey,
+			func(data []byte) ([]byte, error) {
+				params, err := parseParams(data)
+				if err != nil {
+					return nil, err
+				}
+				return AddressStoreKey(params.Address), nil
+			},
+			func(data, value []byte) (interface{}, sdk.Error) {
+				params, err := parseParams(data)
+				if err != nil {
+					return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+				}
+				if len(value) == 0 {
+					return nil, errUnknownAccount(params.Address)
+				}
+
+				var account Account
+				if err := cdc.UnmarshalBinaryBare(value, &account); err != nil {
+					return nil, sdk.ErrInternal(fmt.Sprintf("failed to decode account: %s", err))
+				}
+				return account, nil
+			},
+		),
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func Test_queryAccount(t *testing.T) {
@@ -39,3 +41,36 @@ func Test_queryAccount(t *testing.T) {
 	err2 := input.cdc.UnmarshalJSON(res, &account)
 	require.Nil(t, err2)
 }
+
+func TestProvableQueries(t *testing.T) {
+	input := setupTestInput()
+	path := fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryAccount)
+	query := ProvableQueries(input.cdc)[path]
+	require.Equal(t, StoreKey, query.Store)
+
+	// rebuilds the querier result from the values of the keys
+	provedResult := func(data []byte) ([]byte, sdk.Error) {
+		keys, err := query.Keys(data)
+		require.NoError(t, err)
+		values := make([][]byte, len(keys))
+		for i, key := range keys {
+			values[i] = input.ctx.KVStore(input.ak.key).Get(key)
+		}
+		return query.Result(data, values)
+	}
+
+	_, _, addr := keyPubAddr()
+	req := abci.RequestQuery{Path: path, Data: input.cdc.MustMarshalJSON(NewQueryAccountParams(addr))}
+
+	_, err := provedResult(req.Data)
+	require.NotNil(t, err)
+	_, expErr := queryAccount(input.ctx, req, input.ak)
+	require.Equal(t, expErr, err)
+
+	input.ak.SetAccount(input.ctx, input.ak.NewAccountWithAddress(input.ctx, addr))
+	res, err := provedResult(req.Data)
+	require.Nil(t, err)
+	expRes, expErr := queryAccount(input.ctx, req, input.ak)
+	require.Nil(t, expErr)
+	require.Equal(t, expRes, res)
+}
@@ -12,7 +12,7 @@ import (
 )
 
 // SimulateDeductFee
-func SimulateDeductFee(m auth.AccountKeeper, f auth.FeeCollectionKeeper) simulation.Operation {
+func SimulateDeductFee(m auth.AccountKeeper, f auth.FeeCollectionKeeper, s auth.SupplyKeeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account) (
 		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
@@ -53,7 +53,12 @@ func SimulateDeductFee(m auth.AccountKeeper, f auth.FeeCollectionKeeper) simulat
 		}
 
 		m.SetAccount(ctx, stored)
+		if res := auth.CollectFees(ctx, m, fees); !res.IsOK() {
+			panic(res.Log)
+		}
 		f.AddCollectedFees(ctx, fees)
+		s.DeflateLiquid(ctx, fees)
+		s.InflateModules(ctx, fees)
 
 		opMsg.OK = true
 		return opMsg, nil, nil
@@ -19,6 +19,7 @@ type testInput struct {
 	ctx sdk.Context
 	ak  AccountKeeper
 	fck FeeCollectionKeeper
+	sk  *dummySupplyKeeper
 }
 
 func setupTestInput() testInput {
@@ -46,7 +47,21 @@ func setupTestInput() testInput {
 
 	ak.SetParams(ctx, DefaultParams())
 
-	return testInput{cdc: cdc, ctx: ctx, ak: ak, fck: fck}
+	return testInput{cdc: cdc, ctx: ctx, ak: ak, fck: fck, sk: &dummySupplyKeeper{}}
+}
+
+// dummySupplyKeeper records the supply changes reported by the ante handler
+type dummySupplyKeeper struct {
+	liquidDeflated  sdk.Coins
+	modulesInflated sdk.Coins
+}
+
+func (sk *dummySupplyKeeper) DeflateLiquid(_ sdk.Context, amt sdk.Coins) {
+	sk.liquidDeflated = sk.liquidDeflated.Add(amt)
+}
+
+func (sk *dummySupplyKeeper) InflateModules(_ sdk.Context, amt sdk.Coins) {
+	sk.modulesInflated = sk.modulesInflated.Add(amt)
 }
 
 func newTestMsg(addrs ...sdk.AccAddress) *sdk.TestMsg {
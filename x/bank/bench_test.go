@@ -15,14 +15,14 @@ import (
 func getBenchmarkMockApp() (*mock.App, error) {
 	mapp := mock.NewApp()
 
-	RegisterCodec(mapp.Cdc)
 	bankKeeper := NewBaseKeeper(
 		mapp.AccountKeeper,
+		mapp.SupplyKeeper,
 		mapp.ParamsKeeper.Subspace(DefaultParamspace),
 		DefaultCodespace,
+		nil,
 	)
-	mapp.Router().AddRoute("bank", NewHandler(bankKeeper))
-	mapp.SetInitChainer(getInitChainer(mapp, bankKeeper))
+	mapp.AddModules(NewAppModule(bankKeeper, mapp.AccountKeeper))
 
 	err := mapp.CompleteSetup()
 	return mapp, err
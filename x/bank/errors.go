@@ -1,6 +1,8 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +12,7 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeModuleAccount        sdk.CodeType = 103
 )
 
 // ErrNoInputs is an error
@@ -31,3 +34,23 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrSendDenomDisabled is an error
+func ErrSendDenomDisabled(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
+}
+
+// ErrUnknownModuleAccount is an error
+func ErrUnknownModuleAccount(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeModuleAccount, fmt.Sprintf("module %s has no module account", name))
+}
+
+// ErrModuleAccountPermission is an error
+func ErrModuleAccountPermission(codespace sdk.CodespaceType, name, permission string) sdk.Error {
+	return sdk.NewError(codespace, CodeModuleAccount, fmt.Sprintf("module account %s does not have the %s permission", name, permission))
+}
+
+// ErrSendToModuleAccount is an error
+func ErrSendToModuleAccount(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeModuleAccount, fmt.Sprintf("%s is a module account and cannot receive liquid coins", addr))
+}
@@ -0,0 +1,14 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// expected supply keeper, recording the coins added to and removed from
+// accounts, and the coins moved between accounts and the staking pools
+type SupplyKeeper interface {
+	InflateLiquid(ctx sdk.Context, amt sdk.Coins)
+	DeflateLiquid(ctx sdk.Context, amt sdk.Coins)
+	InflateModules(ctx sdk.Context, amt sdk.Coins)
+	DeflateModules(ctx sdk.Context, amt sdk.Coins)
+}
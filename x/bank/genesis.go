@@ -6,27 +6,34 @@ import (
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled"`
+	SendEnabled       bool          `json:"send_enabled"`
+	SendEnabledDenoms SendEnabledDenoms `json:"send_enabled_denoms"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(sendEnabled bool, sendEnabledDenoms SendEnabledDenoms) GenesisState {
+	return GenesisState{
+		SendEnabled:       sendEnabled,
+		SendEnabledDenoms: sendEnabledDenoms,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState { return NewGenesisState(DefaultSendEnabled, nil) }
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetSendEnabledDenoms(ctx, data.SendEnabledDenoms)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	return NewGenesisState(keeper.GetSendEnabled(ctx), keeper.GetSendEnabledDenoms(ctx))
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	return data.SendEnabledDenoms.Validate()
+}
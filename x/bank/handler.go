@@ -21,8 +21,11 @@ func NewHandler(k Keeper) sdk.Handler {
 
 // Handle MsgSend.
 func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
-	if !k.GetSendEnabled(ctx) {
-		return ErrSendDisabled(k.Codespace()).Result()
+	if err := checkSendEnabled(ctx, k, msg.Amount); err != nil {
+		return err.Result()
+	}
+	if k.IsModuleAddress(ctx, msg.ToAddress) {
+		return ErrSendToModuleAccount(k.Codespace(), msg.ToAddress).Result()
 	}
 	tags, err := k.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
 	if err != nil {
@@ -37,8 +40,15 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 // Handle MsgMultiSend.
 func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg MsgMultiSend) sdk.Result {
 	// NOTE: totalIn == totalOut should already have been checked
-	if !k.GetSendEnabled(ctx) {
-		return ErrSendDisabled(k.Codespace()).Result()
+	for _, in := range msg.Inputs {
+		if err := checkSendEnabled(ctx, k, in.Coins); err != nil {
+			return err.Result()
+		}
+	}
+	for _, out := range msg.Outputs {
+		if k.IsModuleAddress(ctx, out.Address) {
+			return ErrSendToModuleAccount(k.Codespace(), out.Address).Result()
+		}
 	}
 	tags, err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
@@ -49,3 +59,14 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg MsgMultiSend) sdk.Result
 		Tags: tags,
 	}
 }
+
+// checkSendEnabled returns an error if transfers of any of the given coins'
+// denominations are disabled.
+func checkSendEnabled(ctx sdk.Context, k Keeper, coins sdk.Coins) sdk.Error {
+	for _, coin := range coins {
+		if !k.IsSendEnabled(ctx, coin.Denom) {
+			return ErrSendDenomDisabled(k.Codespace(), coin.Denom)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHandlerSendEnabledDenoms(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
+	handler := NewHandler(bankKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	bankKeeper.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 100), sdk.NewInt64Coin("foocoin", 100)))
+
+	bankKeeper.SetSendEnabled(ctx, true)
+	bankKeeper.SetSendEnabledDenoms(ctx, []SendEnabled{NewSendEnabled("foocoin", false)})
+
+	foo := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+	bar := sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10))
+
+	res := handler(ctx, NewMsgSend(addr, addr2, foo))
+	require.Equal(t, CodeSendDisabled, res.Code)
+
+	res = handler(ctx, NewMsgSend(addr, addr2, bar))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = handler(ctx, NewMsgMultiSend([]Input{NewInput(addr, foo.Add(bar))}, []Output{NewOutput(addr2, foo.Add(bar))}))
+	require.Equal(t, CodeSendDisabled, res.Code)
+
+	res = handler(ctx, NewMsgMultiSend([]Input{NewInput(addr, bar)}, []Output{NewOutput(addr2, bar)}))
+	require.True(t, res.IsOK(), res.Log)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 20)), bankKeeper.GetCoins(ctx, addr2))
+}
+
+func TestHandlerSendToModuleAccount(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
+	bankKeeper.SetSendEnabled(ctx, true)
+	handler := NewHandler(bankKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+	bankKeeper.SetCoins(ctx, addr, coins)
+
+	moduleAddr := bankKeeper.GetModuleAddress("holder")
+
+	res := handler(ctx, NewMsgSend(addr, moduleAddr, coins))
+	require.Equal(t, CodeModuleAccount, res.Code)
+
+	res = handler(ctx, NewMsgMultiSend([]Input{NewInput(addr, coins)}, []Output{NewOutput(moduleAddr, coins)}))
+	require.Equal(t, CodeModuleAccount, res.Code)
+
+	require.Equal(t, coins, bankKeeper.GetCoins(ctx, addr))
+}
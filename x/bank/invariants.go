@@ -1,4 +1,4 @@
-package simulation
+package bank
 
 import (
 	"errors"
@@ -8,6 +8,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
+// RegisterInvariants registers the bank module invariants
+func RegisterInvariants(ir sdk.InvariantRouter, ak auth.AccountKeeper) {
+	ir.RegisterRoute(ModuleName, "nonnegative-balance",
+		NonnegativeBalanceInvariant(ak))
+}
+
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
 func NonnegativeBalanceInvariant(ak auth.AccountKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
@@ -21,28 +21,54 @@ type Keeper interface {
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
 	InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error)
 
-	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
-	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) *auth.ModuleAccount
+	IsModuleAddress(ctx sdk.Context, addr sdk.AccAddress) bool
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
+
+	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 }
 
-// BaseKeeper manages transfers between accounts. It implements the Keeper interface.
+// BaseKeeper manages transfers between accounts. It implements the Keeper
+// interface, and records the coins it adds to or removes from accounts in the
+// supply.
 type BaseKeeper struct {
 	BaseSendKeeper
 
 	ak         auth.AccountKeeper
+	sk         SupplyKeeper
 	paramSpace params.Subspace
+
+	// permissions of the module accounts, by module name
+	maccPerms map[string][]string
 }
 
-// NewBaseKeeper returns a new BaseKeeper
-func NewBaseKeeper(ak auth.AccountKeeper,
+// NewBaseKeeper returns a new BaseKeeper. The module accounts of the modules in
+// maccPerms are created with the given permissions on first use.
+func NewBaseKeeper(ak auth.AccountKeeper, sk SupplyKeeper,
 	paramSpace params.Subspace,
-	codespace sdk.CodespaceType) BaseKeeper {
+	codespace sdk.CodespaceType, maccPerms map[string][]string) BaseKeeper {
+
+	for name, perms := range maccPerms {
+		if err := auth.ValidatePermissions(perms...); err != nil {
+			panic(fmt.Sprintf("invalid permissions of module account %s: %v", name, err))
+		}
+	}
 
 	ps := paramSpace.WithKeyTable(ParamKeyTable())
 	return BaseKeeper{
 		BaseSendKeeper: NewBaseSendKeeper(ak, ps, codespace),
 		ak:             ak,
+		sk:             sk,
 		paramSpace:     ps,
+		maccPerms:      maccPerms,
 	}
 }
 
@@ -54,7 +80,15 @@ func (keeper BaseKeeper) SetCoins(
 	if !amt.IsValid() {
 		return sdk.ErrInvalidCoins(amt.String())
 	}
-	return setCoins(ctx, keeper.ak, addr, amt)
+
+	oldCoins := getCoins(ctx, keeper.ak, addr)
+	if err := setCoins(ctx, keeper.ak, addr, amt); err != nil {
+		return err
+	}
+
+	keeper.sk.InflateLiquid(ctx, amt)
+	keeper.sk.DeflateLiquid(ctx, oldCoins)
+	return nil
 }
 
 // SubtractCoins subtracts amt from the coins at the addr.
@@ -65,7 +99,14 @@ func (keeper BaseKeeper) SubtractCoins(
 	if !amt.IsValid() {
 		return nil, nil, sdk.ErrInvalidCoins(amt.String())
 	}
-	return subtractCoins(ctx, keeper.ak, addr, amt)
+
+	newCoins, tags, err := subtractCoins(ctx, keeper.ak, addr, amt)
+	if err != nil {
+		return newCoins, tags, err
+	}
+
+	keeper.sk.DeflateLiquid(ctx, amt)
+	return newCoins, tags, nil
 }
 
 // AddCoins adds amt to the coins at the addr.
@@ -76,7 +117,14 @@ func (keeper BaseKeeper) AddCoins(
 	if !amt.IsValid() {
 		return nil, nil, sdk.ErrInvalidCoins(amt.String())
 	}
-	return addCoins(ctx, keeper.ak, addr, amt)
+
+	newCoins, tags, err := addCoins(ctx, keeper.ak, addr, amt)
+	if err != nil {
+		return newCoins, tags, err
+	}
+
+	keeper.sk.InflateLiquid(ctx, amt)
+	return newCoins, tags, nil
 }
 
 // InputOutputCoins handles a list of inputs and outputs
@@ -87,31 +135,211 @@ func (keeper BaseKeeper) InputOutputCoins(
 	return inputOutputCoins(ctx, keeper.ak, inputs, outputs)
 }
 
-// DelegateCoins performs delegation by deducting amt coins from an account with
-// address addr. For vesting accounts, delegations amounts are tracked for both
-// vesting and vested coins.
-func (keeper BaseKeeper) DelegateCoins(
-	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+// GetModuleAddress returns the address of the module account of the given
+// module, or nil if the module has no module account.
+func (keeper BaseKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	if _, ok := keeper.maccPerms[name]; !ok {
+		return nil
+	}
+	return auth.NewModuleAddress(name)
+}
+
+// GetModuleAccount returns the module account of the given module, creating it
+// with the module's permissions if it does not exist yet. It returns nil if the
+// module has no module account.
+func (keeper BaseKeeper) GetModuleAccount(ctx sdk.Context, name string) *auth.ModuleAccount {
+	perms, ok := keeper.maccPerms[name]
+	if !ok {
+		return nil
+	}
+
+	acc := keeper.ak.GetAccount(ctx, auth.NewModuleAddress(name))
+	if acc == nil {
+		macc := auth.NewEmptyModuleAccount(name, perms...)
+		keeper.ak.SetAccount(ctx, keeper.ak.NewAccount(ctx, macc))
+		return macc
+	}
+
+	macc, ok := acc.(*auth.ModuleAccount)
+	if !ok {
+		panic(fmt.Sprintf("account %s of module %s is not a module account", acc.GetAddress(), name))
+	}
+	return macc
+}
+
+// IsModuleAddress returns whether the address belongs to a module account.
+// Coins cannot be sent to module accounts by users.
+func (keeper BaseKeeper) IsModuleAddress(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for name := range keeper.maccPerms {
+		if addr.Equals(auth.NewModuleAddress(name)) {
+			return true
+		}
+	}
+
+	_, ok := keeper.ak.GetAccount(ctx, addr).(*auth.ModuleAccount)
+	return ok
+}
+
+// SendCoinsFromModuleToAccount transfers coins from a module account to an
+// account. The recipient cannot be a module account, as the coins are booked
+// as liquid supply.
+func (keeper BaseKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) sdk.Error {
+
+	if keeper.IsModuleAddress(ctx, recipientAddr) {
+		return ErrSendToModuleAccount(keeper.Codespace(), recipientAddr)
+	}
+
+	senderAcc, err := keeper.moduleAccount(ctx, senderModule, "")
+	if err != nil {
+		return err
+	}
+
+	if _, err := sendCoins(ctx, keeper.ak, senderAcc.GetAddress(), recipientAddr, amt); err != nil {
+		return err
+	}
+
+	keeper.sk.DeflateModules(ctx, amt)
+	keeper.sk.InflateLiquid(ctx, amt)
+	return nil
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module
+// account.
+func (keeper BaseKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) sdk.Error {
+
+	recipientAcc, err := keeper.moduleAccount(ctx, recipientModule, "")
+	if err != nil {
+		return err
+	}
+
+	if _, err := sendCoins(ctx, keeper.ak, senderAddr, recipientAcc.GetAddress(), amt); err != nil {
+		return err
+	}
+
+	keeper.sk.DeflateLiquid(ctx, amt)
+	keeper.sk.InflateModules(ctx, amt)
+	return nil
+}
+
+// SendCoinsFromModuleToModule transfers coins from a module account to another.
+func (keeper BaseKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+) sdk.Error {
+
+	senderAcc, err := keeper.moduleAccount(ctx, senderModule, "")
+	if err != nil {
+		return err
+	}
+
+	recipientAcc, err := keeper.moduleAccount(ctx, recipientModule, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = sendCoins(ctx, keeper.ak, senderAcc.GetAddress(), recipientAcc.GetAddress(), amt)
+	return err
+}
+
+// DelegateCoinsFromAccountToModule delegates coins of an account to a module
+// account with the staking permission. For vesting accounts, delegations
+// amounts are tracked for both vesting and vested coins.
+func (keeper BaseKeeper) DelegateCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
 ) (sdk.Tags, sdk.Error) {
 
-	if !amt.IsValid() {
-		return nil, sdk.ErrInvalidCoins(amt.String())
+	recipientAcc, err := keeper.moduleAccount(ctx, recipientModule, auth.Staking)
+	if err != nil {
+		return nil, err
 	}
-	return delegateCoins(ctx, keeper.ak, addr, amt)
+
+	tags, err := delegateCoins(ctx, keeper.ak, senderAddr, amt)
+	if err != nil {
+		return tags, err
+	}
+
+	if _, _, err := addCoins(ctx, keeper.ak, recipientAcc.GetAddress(), amt); err != nil {
+		return nil, err
+	}
+
+	keeper.sk.DeflateLiquid(ctx, amt)
+	keeper.sk.InflateModules(ctx, amt)
+	return tags, nil
 }
 
-// UndelegateCoins performs undelegation by crediting amt coins to an account with
-// address addr. For vesting accounts, undelegation amounts are tracked for both
-// vesting and vested coins.
-// If any of the undelegation amounts are negative, an error is returned.
-func (keeper BaseKeeper) UndelegateCoins(
-	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
+// UndelegateCoinsFromModuleToAccount returns coins delegated to a module
+// account with the staking permission to an account. For vesting accounts,
+// undelegation amounts are tracked for both vesting and vested coins.
+func (keeper BaseKeeper) UndelegateCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
 ) (sdk.Tags, sdk.Error) {
 
-	if !amt.IsValid() {
-		return nil, sdk.ErrInvalidCoins(amt.String())
+	senderAcc, err := keeper.moduleAccount(ctx, senderModule, auth.Staking)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := subtractCoins(ctx, keeper.ak, senderAcc.GetAddress(), amt); err != nil {
+		return nil, err
+	}
+
+	tags, err := undelegateCoins(ctx, keeper.ak, recipientAddr, amt)
+	if err != nil {
+		return tags, err
+	}
+
+	keeper.sk.DeflateModules(ctx, amt)
+	keeper.sk.InflateLiquid(ctx, amt)
+	return tags, nil
+}
+
+// MintCoins creates new coins in the account of a module with the minter
+// permission.
+func (keeper BaseKeeper) MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error {
+	acc, err := keeper.moduleAccount(ctx, name, auth.Minter)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := addCoins(ctx, keeper.ak, acc.GetAddress(), amt); err != nil {
+		return err
+	}
+
+	keeper.sk.InflateModules(ctx, amt)
+	return nil
+}
+
+// BurnCoins destroys coins held by the account of a module with the burner
+// permission.
+func (keeper BaseKeeper) BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error {
+	acc, err := keeper.moduleAccount(ctx, name, auth.Burner)
+	if err != nil {
+		return err
 	}
-	return undelegateCoins(ctx, keeper.ak, addr, amt)
+
+	if _, _, err := subtractCoins(ctx, keeper.ak, acc.GetAddress(), amt); err != nil {
+		return err
+	}
+
+	keeper.sk.DeflateModules(ctx, amt)
+	return nil
+}
+
+// moduleAccount returns the module account of the given module, checking that
+// it has the given permission unless the permission is empty.
+func (keeper BaseKeeper) moduleAccount(ctx sdk.Context, name, permission string) (*auth.ModuleAccount, sdk.Error) {
+	acc := keeper.GetModuleAccount(ctx, name)
+	if acc == nil {
+		return nil, ErrUnknownModuleAccount(keeper.Codespace(), name)
+	}
+
+	if permission != "" && !acc.HasPermission(permission) {
+		return nil, ErrModuleAccountPermission(keeper.Codespace(), name, permission)
+	}
+	return acc, nil
 }
 
 // SendKeeper defines a module interface that facilitates the transfer of coins
@@ -123,6 +351,10 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+
+	GetSendEnabledDenoms(ctx sdk.Context) SendEnabledDenoms
+	SetSendEnabledDenoms(ctx sdk.Context, entries SendEnabledDenoms)
+	IsSendEnabled(ctx sdk.Context, denom string) bool
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -171,6 +403,29 @@ func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
 	keeper.paramSpace.Set(ctx, ParamStoreKeySendEnabled, &enabled)
 }
 
+// GetSendEnabledDenoms returns the per-denomination send enabled overrides
+func (keeper BaseSendKeeper) GetSendEnabledDenoms(ctx sdk.Context) SendEnabledDenoms {
+	var entries SendEnabledDenoms
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeySendEnabledDenoms, &entries)
+	return entries
+}
+
+// SetSendEnabledDenoms sets the per-denomination send enabled overrides
+func (keeper BaseSendKeeper) SetSendEnabledDenoms(ctx sdk.Context, entries SendEnabledDenoms) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeySendEnabledDenoms, &entries)
+}
+
+// IsSendEnabled returns whether transfers of the given denomination are
+// enabled. A per-denomination override takes precedence over SendEnabled.
+func (keeper BaseSendKeeper) IsSendEnabled(ctx sdk.Context, denom string) bool {
+	for _, se := range keeper.GetSendEnabledDenoms(ctx) {
+		if se.Denom == denom {
+			return se.Enabled
+		}
+	}
+	return keeper.GetSendEnabled(ctx)
+}
+
 var _ ViewKeeper = (*BaseViewKeeper)(nil)
 
 // ViewKeeper defines a module interface that facilitates read only access to
@@ -15,13 +15,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
+var maccPerms = map[string][]string{
+	"holder": nil,
+	"minter": {auth.Minter},
+	"burner": {auth.Burner},
+	"staker": {auth.Staking},
+}
+
 type testInput struct {
 	cdc *codec.Codec
 	ctx sdk.Context
 	ak  auth.AccountKeeper
 	pk  params.Keeper
+	sk  supply.Keeper
 }
 
 func setupTestInput() testInput {
@@ -32,12 +41,14 @@ func setupTestInput() testInput {
 
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
 	fckCapKey := sdk.NewKVStoreKey("fckCapKey")
+	keySupply := sdk.NewKVStoreKey("supply")
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(fckCapKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
@@ -46,17 +57,18 @@ func setupTestInput() testInput {
 	ak := auth.NewAccountKeeper(
 		cdc, authCapKey, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount,
 	)
+	sk := supply.NewKeeper(cdc, keySupply)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 
 	ak.SetParams(ctx, auth.DefaultParams())
 
-	return testInput{cdc: cdc, ctx: ctx, ak: ak, pk: pk}
+	return testInput{cdc: cdc, ctx: ctx, ak: ak, pk: pk, sk: sk}
 }
 
 func TestKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
 	bankKeeper.SetSendEnabled(ctx, true)
 
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -137,7 +149,7 @@ func TestSendKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
 	paramSpace := input.pk.Subspace(DefaultParamspace)
-	bankKeeper := NewBaseKeeper(input.ak, paramSpace, DefaultCodespace)
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, paramSpace, DefaultCodespace, maccPerms)
 	sendKeeper := NewBaseSendKeeper(input.ak, paramSpace, DefaultCodespace)
 	bankKeeper.SetSendEnabled(ctx, true)
 
@@ -186,7 +198,7 @@ func TestViewKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
 	paramSpace := input.pk.Subspace(DefaultParamspace)
-	bankKeeper := NewBaseKeeper(input.ak, paramSpace, DefaultCodespace)
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, paramSpace, DefaultCodespace, maccPerms)
 	bankKeeper.SetSendEnabled(ctx, true)
 	viewKeeper := NewBaseViewKeeper(input.ak, DefaultCodespace)
 
@@ -207,6 +219,30 @@ func TestViewKeeper(t *testing.T) {
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
 }
 
+func TestSendEnabledDenoms(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
+
+	// no overrides: every denomination follows SendEnabled
+	bankKeeper.SetSendEnabled(ctx, true)
+	require.Empty(t, bankKeeper.GetSendEnabledDenoms(ctx))
+	require.True(t, bankKeeper.IsSendEnabled(ctx, "foocoin"))
+	require.True(t, bankKeeper.IsSendEnabled(ctx, "barcoin"))
+
+	// overrides take precedence in both directions
+	entries := SendEnabledDenoms{NewSendEnabled("foocoin", false)}
+	bankKeeper.SetSendEnabledDenoms(ctx, entries)
+	require.Equal(t, entries, bankKeeper.GetSendEnabledDenoms(ctx))
+	require.False(t, bankKeeper.IsSendEnabled(ctx, "foocoin"))
+	require.True(t, bankKeeper.IsSendEnabled(ctx, "barcoin"))
+
+	bankKeeper.SetSendEnabled(ctx, false)
+	bankKeeper.SetSendEnabledDenoms(ctx, []SendEnabled{NewSendEnabled("foocoin", true)})
+	require.True(t, bankKeeper.IsSendEnabled(ctx, "foocoin"))
+	require.False(t, bankKeeper.IsSendEnabled(ctx, "barcoin"))
+}
+
 func TestVestingAccountSend(t *testing.T) {
 	input := setupTestInput()
 	now := tmtime.Now()
@@ -215,7 +251,7 @@ func TestVestingAccountSend(t *testing.T) {
 
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
 	bankKeeper.SetSendEnabled(ctx, true)
 
 	addr1 := sdk.AccAddress([]byte("addr1"))
@@ -249,7 +285,7 @@ func TestVestingAccountReceive(t *testing.T) {
 
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
 	bankKeeper.SetSendEnabled(ctx, true)
 
 	addr1 := sdk.AccAddress([]byte("addr1"))
@@ -283,7 +319,7 @@ func TestDelegateCoins(t *testing.T) {
 
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
 	bankKeeper.SetSendEnabled(ctx, true)
 
 	addr1 := sdk.AccAddress([]byte("addr1"))
@@ -296,20 +332,29 @@ func TestDelegateCoins(t *testing.T) {
 	input.ak.SetAccount(ctx, vacc)
 	input.ak.SetAccount(ctx, acc)
 	bankKeeper.SetCoins(ctx, addr2, origCoins)
+	input.sk.InflateLiquid(ctx, origCoins.Add(origCoins))
 
 	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
 
 	// require the ability for a non-vesting account to delegate
-	_, err := bankKeeper.DelegateCoins(ctx, addr2, delCoins)
+	_, err := bankKeeper.DelegateCoinsFromAccountToModule(ctx, addr2, "staker", delCoins)
 	acc = input.ak.GetAccount(ctx, addr2)
 	require.NoError(t, err)
 	require.Equal(t, delCoins, acc.GetCoins())
 
 	// require the ability for a vesting account to delegate
-	_, err = bankKeeper.DelegateCoins(ctx, addr1, delCoins)
+	_, err = bankKeeper.DelegateCoinsFromAccountToModule(ctx, addr1, "staker", delCoins)
 	vacc = input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
 	require.NoError(t, err)
 	require.Equal(t, delCoins, vacc.GetCoins())
+
+	// require the delegated coins to be held by the module account
+	require.Equal(t, origCoins, bankKeeper.GetModuleAccount(ctx, "staker").GetCoins())
+	require.Equal(t, origCoins, input.sk.GetSupply(ctx).Modules)
+
+	// require delegating to a module account without the staking permission to fail
+	_, err = bankKeeper.DelegateCoinsFromAccountToModule(ctx, addr2, "holder", delCoins)
+	require.Error(t, err)
 }
 
 func TestUndelegateCoins(t *testing.T) {
@@ -320,7 +365,7 @@ func TestUndelegateCoins(t *testing.T) {
 
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
 	bankKeeper.SetSendEnabled(ctx, true)
 
 	addr1 := sdk.AccAddress([]byte("addr1"))
@@ -333,28 +378,87 @@ func TestUndelegateCoins(t *testing.T) {
 	input.ak.SetAccount(ctx, vacc)
 	input.ak.SetAccount(ctx, acc)
 	bankKeeper.SetCoins(ctx, addr2, origCoins)
+	input.sk.InflateLiquid(ctx, origCoins.Add(origCoins))
 
 	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
 
 	// require the ability for a non-vesting account to delegate
-	_, err := bankKeeper.DelegateCoins(ctx, addr2, delCoins)
+	_, err := bankKeeper.DelegateCoinsFromAccountToModule(ctx, addr2, "staker", delCoins)
 	require.NoError(t, err)
 
 	// require the ability for a non-vesting account to undelegate
-	_, err = bankKeeper.UndelegateCoins(ctx, addr2, delCoins)
+	_, err = bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, "staker", addr2, delCoins)
 	require.NoError(t, err)
 
 	acc = input.ak.GetAccount(ctx, addr2)
 	require.Equal(t, origCoins, acc.GetCoins())
 
 	// require the ability for a vesting account to delegate
-	_, err = bankKeeper.DelegateCoins(ctx, addr1, delCoins)
+	_, err = bankKeeper.DelegateCoinsFromAccountToModule(ctx, addr1, "staker", delCoins)
 	require.NoError(t, err)
 
 	// require the ability for a vesting account to undelegate
-	_, err = bankKeeper.UndelegateCoins(ctx, addr1, delCoins)
+	_, err = bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, "staker", addr1, delCoins)
 	require.NoError(t, err)
 
 	vacc = input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
 	require.Equal(t, origCoins, vacc.GetCoins())
 }
+
+func TestModuleAccounts(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.ak, input.sk, input.pk.Subspace(DefaultParamspace), DefaultCodespace, maccPerms)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	addr := sdk.AccAddress([]byte("addr1"))
+
+	// module accounts are created on first use with their permissions
+	require.Nil(t, bankKeeper.GetModuleAccount(ctx, "unknown"))
+	require.Nil(t, bankKeeper.GetModuleAddress("unknown"))
+	minterAcc := bankKeeper.GetModuleAccount(ctx, "minter")
+	require.Equal(t, bankKeeper.GetModuleAddress("minter"), minterAcc.GetAddress())
+	require.True(t, minterAcc.HasPermission(auth.Minter))
+	require.True(t, bankKeeper.IsModuleAddress(ctx, minterAcc.GetAddress()))
+	require.False(t, bankKeeper.IsModuleAddress(ctx, addr))
+
+	// only modules with the minter permission can mint
+	require.Error(t, bankKeeper.MintCoins(ctx, "holder", coins))
+	require.Error(t, bankKeeper.MintCoins(ctx, "unknown", coins))
+	require.NoError(t, bankKeeper.MintCoins(ctx, "minter", coins))
+	require.Equal(t, coins, bankKeeper.GetModuleAccount(ctx, "minter").GetCoins())
+	require.Equal(t, coins, input.sk.GetSupply(ctx).Modules)
+
+	// transfers between modules keep the coins module held
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, "minter", "burner", coins))
+	require.True(t, bankKeeper.GetModuleAccount(ctx, "minter").GetCoins().IsZero())
+	require.Equal(t, coins, input.sk.GetSupply(ctx).Modules)
+
+	// transfers to and from accounts move the coins between module held and
+	// liquid supply
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, "burner", addr, coins))
+	require.Equal(t, coins, bankKeeper.GetCoins(ctx, addr))
+	require.Equal(t, coins, input.sk.GetSupply(ctx).Liquid)
+	require.True(t, input.sk.GetSupply(ctx).Modules.IsZero())
+	require.Error(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, "burner", addr, coins))
+
+	// module accounts cannot receive coins booked as liquid supply
+	require.NoError(t, bankKeeper.SendCoinsFromAccountToModule(ctx, addr, "burner", coins))
+	err := bankKeeper.SendCoinsFromModuleToAccount(ctx, "burner", minterAcc.GetAddress(), coins)
+	require.Error(t, err)
+	require.Equal(t, CodeModuleAccount, err.Code())
+	require.Equal(t, coins, bankKeeper.GetModuleAccount(ctx, "burner").GetCoins())
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, "burner", addr, coins))
+
+	require.NoError(t, bankKeeper.SendCoinsFromAccountToModule(ctx, addr, "burner", coins))
+	require.Equal(t, coins, bankKeeper.GetModuleAccount(ctx, "burner").GetCoins())
+	require.True(t, input.sk.GetSupply(ctx).Liquid.IsZero())
+
+	// only modules with the burner permission can burn
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, "burner", "holder", coins))
+	require.Error(t, bankKeeper.BurnCoins(ctx, "holder", coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, "holder", "burner", coins))
+	require.NoError(t, bankKeeper.BurnCoins(ctx, "burner", coins))
+	require.True(t, bankKeeper.GetModuleAccount(ctx, "burner").GetCoins().IsZero())
+	require.True(t, input.sk.TotalSupply(ctx).IsZero())
+}
@@ -0,0 +1,102 @@
+package bank
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the bank module.
+type AppModule struct {
+	keeper        Keeper
+	accountKeeper auth.AccountKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper, accountKeeper auth.AccountKeeper) AppModule {
+	return AppModule{
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+	}
+}
+
+// Name returns the bank module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the bank module's types for the given codec
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterInvariants registers the bank module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	RegisterInvariants(ir, am.accountKeeper)
+}
+
+// Route returns the message routing key for the bank module
+func (AppModule) Route() string {
+	return RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the bank module
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// QuerierRoute returns the bank module's querier route name; bank has no
+// querier
+func (AppModule) QuerierRoute() string { return "" }
+
+// NewQuerierHandler returns the bank module sdk.Querier
+func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+
+// DefaultGenesis returns default genesis state as raw bytes for the bank
+// module
+func (AppModule) DefaultGenesis() json.RawMessage {
+	return msgCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the bank module
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := msgCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// InitGenesis performs genesis initialization for the bank module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	msgCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the bank
+// module
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return msgCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the bank module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the bank module. It returns no validator
+// updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
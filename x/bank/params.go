@@ -1,6 +1,9 @@
 package bank
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -11,12 +14,60 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+	// ParamStoreKeySendEnabledDenoms is store's key for SendEnabledDenoms
+	ParamStoreKeySendEnabledDenoms = []byte("sendenableddenoms")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		ParamStoreKeySendEnabled, false,
+		ParamStoreKeySendEnabledDenoms, SendEnabledDenoms{},
 	)
 }
+
+// SendEnabled overrides the module-wide send enabled flag for a single
+// denomination.
+type SendEnabled struct {
+	Denom   string `json:"denom"`
+	Enabled bool   `json:"enabled"`
+}
+
+// NewSendEnabled creates a new SendEnabled object.
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{Denom: denom, Enabled: enabled}
+}
+
+// String implements the Stringer interface.
+func (se SendEnabled) String() string {
+	return fmt.Sprintf("%s:%t", se.Denom, se.Enabled)
+}
+
+// SendEnabledDenoms is the list of per-denomination send enabled overrides.
+type SendEnabledDenoms []SendEnabled
+
+// Validate implements the validation of the parameter, run before it is
+// updated, e.g. by a parameter change proposal.
+func (entries SendEnabledDenoms) Validate() error {
+	return ValidateSendEnabledDenoms(entries)
+}
+
+// ValidateSendEnabledDenoms returns an error if any of the per-denomination
+// send enabled entries has an invalid denomination or if a denomination is
+// listed more than once.
+func ValidateSendEnabledDenoms(entries []SendEnabled) error {
+	seen := make(map[string]bool, len(entries))
+	for _, se := range entries {
+		if !(sdk.Coins{sdk.Coin{Denom: se.Denom, Amount: sdk.OneInt()}}).IsValid() {
+			return fmt.Errorf("invalid send enabled denomination: %s", se.Denom)
+		}
+		if seen[se.Denom] {
+			return fmt.Errorf("duplicate send enabled entry for denomination %s", se.Denom)
+		}
+		seen[se.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSendEnabledDenoms(t *testing.T) {
+	tests := []struct {
+		name      string
+		entries   []SendEnabled
+		expectErr bool
+	}{
+		{"empty", nil, false},
+		{"valid", []SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("barcoin", false)}, false},
+		{"invalid denom", []SendEnabled{NewSendEnabled("FOO", true)}, true},
+		{"duplicate denom", []SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("foocoin", false)}, true},
+	}
+
+	for _, tc := range tests {
+		err := ValidateSendEnabledDenoms(tc.entries)
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+		require.Equal(t, err, SendEnabledDenoms(tc.entries).Validate(), tc.name)
+	}
+}
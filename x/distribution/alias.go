@@ -15,6 +15,8 @@ type (
 	MsgWithdrawDelegatorReward     = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission = types.MsgWithdrawValidatorCommission
 
+	CommunityPoolSpendProposal = types.CommunityPoolSpendProposal
+
 	GenesisState = types.GenesisState
 
 	// expected keepers
@@ -30,12 +32,15 @@ type (
 )
 
 const (
+	ModuleName       = types.ModuleName
 	DefaultCodespace = types.DefaultCodespace
 	CodeInvalidInput = types.CodeInvalidInput
 	StoreKey         = types.StoreKey
 	TStoreKey        = types.TStoreKey
 	RouterKey        = types.RouterKey
 	QuerierRoute     = types.QuerierRoute
+
+	ProposalTypeCommunityPoolSpend = types.ProposalTypeCommunityPoolSpend
 )
 
 var (
@@ -43,12 +48,15 @@ var (
 	ErrNilWithdrawAddr  = types.ErrNilWithdrawAddr
 	ErrNilValidatorAddr = types.ErrNilValidatorAddr
 
+	ErrInsufficientCommunityPool = types.ErrInsufficientCommunityPool
+
 	TagValidator = tags.Validator
 	TagDelegator = tags.Delegator
 
 	NewMsgSetWithdrawAddress          = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward     = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission = types.NewMsgWithdrawValidatorCommission
+	NewCommunityPoolSpendProposal     = types.NewCommunityPoolSpendProposal
 
 	NewKeeper                                 = keeper.NewKeeper
 	NewQuerier                                = keeper.NewQuerier
@@ -59,6 +67,11 @@ var (
 	NewQueryDelegatorParams                   = keeper.NewQueryDelegatorParams
 	NewQueryDelegatorWithdrawAddrParams       = keeper.NewQueryDelegatorWithdrawAddrParams
 	DefaultParamspace                         = keeper.DefaultParamspace
+	RegisterInvariants                        = keeper.RegisterInvariants
+	AllInvariants                             = keeper.AllInvariants
+	NonNegativeOutstandingInvariant           = keeper.NonNegativeOutstandingInvariant
+	CanWithdrawInvariant                      = keeper.CanWithdrawInvariant
+	ReferenceCountInvariant                   = keeper.ReferenceCountInvariant
 
 	RegisterCodec       = types.RegisterCodec
 	DefaultGenesisState = types.DefaultGenesisState
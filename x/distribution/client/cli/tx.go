@@ -2,6 +2,8 @@
 package cli
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -17,6 +19,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/common"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 )
 
 var (
@@ -132,3 +135,75 @@ $ gaiacli tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --f
 	}
 	return cmd
 }
+
+// communityPoolSpendProposal is the content of a community pool spend
+// proposal file
+type communityPoolSpendProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Recipient   string `json:"recipient"`
+	Amount      string `json:"amount"`
+	Deposit     string `json:"deposit"`
+}
+
+// GetCmdSubmitProposal implements the command to submit a community pool
+// spend proposal
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "community-pool-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool spend proposal",
+		Long: strings.TrimSpace(`Submit a community pool spend proposal along with an initial deposit. The proposal is given through a proposal JSON file, with the recipient and the amount to pay from the community pool:
+
+$ gaiacli tx gov submit-proposal community-pool-spend path/to/proposal.json --from mykey
+
+where proposal.json contains:
+
+{
+  "title": "Community Pool Spend",
+  "description": "Pay me some Atoms!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000stake",
+  "deposit": "10stake"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal communityPoolSpendProposal
+			if err := json.Unmarshal(contents, &proposal); err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoins(proposal.Amount)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCommunityPoolSpendProposal(proposal.Title, proposal.Description, recipient, amount)
+			msg := gov.NewMsgSubmitProposal(content, from, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
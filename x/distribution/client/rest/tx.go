@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/common"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -53,6 +55,16 @@ type (
 		BaseReq         rest.BaseReq   `json:"base_req"`
 		WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
 	}
+
+	communityPoolSpendProposalReq struct {
+		BaseReq        rest.BaseReq   `json:"base_req"`
+		Title          string         `json:"title"`
+		Description    string         `json:"description"`
+		Recipient      sdk.AccAddress `json:"recipient"`
+		Amount         sdk.Coins      `json:"amount"`
+		Proposer       sdk.AccAddress `json:"proposer"`
+		InitialDeposit sdk.Coins      `json:"initial_deposit"`
+	}
 )
 
 // Withdraw delegator rewards
@@ -202,3 +214,37 @@ func checkValidatorAddressVar(w http.ResponseWriter, r *http.Request) (sdk.ValAd
 	}
 	return addr, true
 }
+
+// ProposalRESTHandler returns the REST handler submitting community pool
+// spend proposals, registered by the gov module under
+// /gov/proposals/community_pool_spend
+func ProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_spend",
+		Handler:  postProposalHandlerFn(cdc, cliCtx),
+	}
+}
+
+// Submit a community pool spend proposal
+func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req communityPoolSpendProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityPoolSpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
+		msg := gov.NewMsgSubmitProposal(content, req.Proposer, req.InitialDeposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
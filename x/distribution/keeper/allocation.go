@@ -6,6 +6,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // allocate fees handles distribution of the collected fees
@@ -21,6 +23,14 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, sumPreviousPrecommitPower, total
 	feesCollected := sdk.NewDecCoins(feesCollectedInt)
 	k.feeCollectionKeeper.ClearCollectedFees(ctx)
 
+	// transfer the collected fees to the distribution module account
+	if !feesCollectedInt.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, auth.FeeCollectorName, types.ModuleName, feesCollectedInt)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
 	feePool := k.GetFeePool(ctx)
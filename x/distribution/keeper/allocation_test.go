@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -38,7 +39,7 @@ func TestAllocateTokensToValidatorWithCommission(t *testing.T) {
 }
 
 func TestAllocateTokensToManyValidators(t *testing.T) {
-	ctx, _, k, sk, fck := CreateTestInputDefault(t, false, 1000)
+	ctx, ak, k, sk, fck := CreateTestInputDefault(t, false, 1000)
 	sh := staking.NewHandler(sk)
 
 	// create validator with 50% commission
@@ -76,6 +77,7 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 		{sdk.DefaultBondDenom, sdk.NewInt(100)},
 	}
 	fck.SetCollectedFees(fees)
+	require.True(t, auth.CollectFees(ctx, ak, fees).IsOK())
 	votes := []abci.VoteInfo{
 		{
 			Validator:       abciValA,
@@ -105,7 +107,7 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 
 func TestAllocateTokensTruncation(t *testing.T) {
 	communityTax := sdk.NewDec(0)
-	ctx, _, k, sk, fck := CreateTestInputAdvanced(t, false, 1000000, communityTax)
+	ctx, ak, k, sk, fck := CreateTestInputAdvanced(t, false, 1000000, communityTax)
 	sh := staking.NewHandler(sk)
 
 	// create validator with 10% commission
@@ -154,6 +156,7 @@ func TestAllocateTokensTruncation(t *testing.T) {
 		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(634195840)),
 	}
 	fck.SetCollectedFees(fees)
+	require.True(t, auth.CollectFees(ctx, ak, fees).IsOK())
 	votes := []abci.VoteInfo{
 		{
 			Validator:       abciValA,
@@ -1,15 +1,22 @@
-package simulation
+package keeper
 
 import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
+// register all distribution invariants
+func RegisterInvariants(ir sdk.InvariantRouter, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding",
+		NonNegativeOutstandingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariant(k))
+}
+
 // AllInvariants runs all invariants of the distribution module
-func AllInvariants(d distr.Keeper, stk types.StakingKeeper) sdk.Invariant {
+func AllInvariants(d Keeper, stk types.StakingKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 		err := CanWithdrawInvariant(d, stk)(ctx)
 		if err != nil {
@@ -23,12 +30,16 @@ func AllInvariants(d distr.Keeper, stk types.StakingKeeper) sdk.Invariant {
 		if err != nil {
 			return err
 		}
+		err = ModuleAccountInvariant(d)(ctx)
+		if err != nil {
+			return err
+		}
 		return nil
 	}
 }
 
 // NonNegativeOutstandingInvariant checks that outstanding unwithdrawn fees are never negative
-func NonNegativeOutstandingInvariant(k distr.Keeper) sdk.Invariant {
+func NonNegativeOutstandingInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 
 		var outstanding sdk.DecCoins
@@ -51,7 +62,7 @@ func NonNegativeOutstandingInvariant(k distr.Keeper) sdk.Invariant {
 }
 
 // CanWithdrawInvariant checks that current rewards can be completely withdrawn
-func CanWithdrawInvariant(k distr.Keeper, sk types.StakingKeeper) sdk.Invariant {
+func CanWithdrawInvariant(k Keeper, sk types.StakingKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 
 		// cache, we don't want to write changes
@@ -95,7 +106,7 @@ func CanWithdrawInvariant(k distr.Keeper, sk types.StakingKeeper) sdk.Invariant
 }
 
 // ReferenceCountInvariant checks that the number of historical rewards records is correct
-func ReferenceCountInvariant(k distr.Keeper, sk types.StakingKeeper) sdk.Invariant {
+func ReferenceCountInvariant(k Keeper, sk types.StakingKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 
 		valCount := uint64(0)
@@ -125,3 +136,26 @@ func ReferenceCountInvariant(k distr.Keeper, sk types.StakingKeeper) sdk.Invaria
 		return nil
 	}
 }
+
+// ModuleAccountInvariant checks that the coins held by the distribution module
+// account equal the outstanding rewards of all validators plus the community pool
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+
+		var expected sdk.DecCoins
+		k.IterateValidatorOutstandingRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+			expected = expected.Add(rewards)
+			return false
+		})
+		expected = expected.Add(k.GetFeePool(ctx).CommunityPool)
+
+		macc := k.bankKeeper.GetCoins(ctx, k.bankKeeper.GetModuleAddress(types.ModuleName))
+		if !sdk.NewDecCoins(macc).IsEqual(expected) {
+			return fmt.Errorf("distribution module account invariance:\n"+
+				"\tsum of outstanding rewards and community pool: %v\n"+
+				"\tmodule account coins: %v", expected, macc)
+		}
+
+		return nil
+	}
+}
@@ -41,6 +41,10 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 		return types.ErrSetWithdrawAddrDisabled(k.codespace)
 	}
 
+	if k.bankKeeper.IsModuleAddress(ctx, withdrawAddr) {
+		return types.ErrModuleAccountRecipient(k.codespace, withdrawAddr)
+	}
+
 	k.SetDelegatorWithdrawAddr(ctx, delegatorAddr, withdrawAddr)
 
 	return nil
@@ -89,10 +93,37 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		accAddr := sdk.AccAddress(valAddr)
 		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
 
-		if _, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, coins); err != nil {
+		if err := k.sendCoinsToAccount(ctx, withdrawAddr, coins); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// distribute funds from the community pool to a receiver, e.g. for a passed
+// community pool spend proposal, which cannot be a module account
+func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) sdk.Error {
+	if k.bankKeeper.IsModuleAddress(ctx, receiveAddr) {
+		return types.ErrModuleAccountRecipient(k.codespace, receiveAddr)
+	}
+
+	feePool := k.GetFeePool(ctx)
+
+	// only whole coins of the community pool can be distributed, the decimal
+	// change remains in the pool
+	spendable, _ := feePool.CommunityPool.TruncateDecimal()
+	if !spendable.IsAllGTE(amount) {
+		return types.ErrInsufficientCommunityPool(k.codespace)
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoins(amount))
+	k.SetFeePool(ctx, feePool)
+
+	return k.sendCoinsToAccount(ctx, receiveAddr, amount)
+}
+
+// send coins held by the distribution module account to an account
+func (k Keeper) sendCoinsToAccount(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amt)
+}
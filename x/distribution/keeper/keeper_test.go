@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -20,6 +22,12 @@ func TestSetWithdrawAddr(t *testing.T) {
 
 	err = keeper.SetWithdrawAddr(ctx, delAddr1, delAddr2)
 	require.Nil(t, err)
+
+	// rewards cannot be withdrawn to module accounts
+	err = keeper.SetWithdrawAddr(ctx, delAddr1, auth.NewModuleAddress(auth.FeeCollectorName))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeModuleAccountRecipient, err.Code())
+	require.Equal(t, delAddr2, keeper.GetDelegatorWithdrawAddr(ctx, delAddr1))
 }
 
 func TestWithdrawValidatorCommission(t *testing.T) {
@@ -62,3 +70,37 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 
 	require.True(t, true)
 }
+
+func TestDistributeFromFeePool(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	// set the community pool
+	feePool := keeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", sdk.NewDec(5).Quo(sdk.NewDec(4))),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(3).Quo(sdk.NewDec(2))),
+	}
+	keeper.SetFeePool(ctx, feePool)
+
+	// the decimal change of the pool cannot be distributed
+	err := keeper.DistributeFromFeePool(ctx, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(2))}, delAddr1)
+	require.NotNil(t, err)
+	err = keeper.DistributeFromFeePool(ctx, sdk.Coins{sdk.NewCoin("othertoken", sdk.NewInt(1))}, delAddr1)
+	require.NotNil(t, err)
+	err = keeper.DistributeFromFeePool(ctx, sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(1))}, auth.NewModuleAddress(auth.FeeCollectorName))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeModuleAccountRecipient, err.Code())
+	require.Equal(t, feePool, keeper.GetFeePool(ctx))
+
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+	amount := sdk.Coins{sdk.NewCoin("mytoken", sdk.NewInt(1)), sdk.NewCoin("stake", sdk.NewInt(1))}
+	err = keeper.DistributeFromFeePool(ctx, amount, delAddr1)
+	require.Nil(t, err)
+
+	// check balance increase and remainder
+	require.Equal(t, balance.Add(amount), ak.GetAccount(ctx, delAddr1).GetCoins())
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", sdk.NewDec(1).Quo(sdk.NewDec(4))),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(1).Quo(sdk.NewDec(2))),
+	}, keeper.GetFeePool(ctx).CommunityPool)
+}
@@ -109,6 +109,19 @@ func getQueriedDelegationRewards(t *testing.T, ctx sdk.Context, cdc *codec.Codec
 	return
 }
 
+func getQueriedCommunityPool(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (communityPool sdk.DecCoins) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, QueryCommunityPool}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{QueryCommunityPool}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &communityPool))
+
+	return
+}
+
 func TestQueries(t *testing.T) {
 	cdc := codec.New()
 	ctx, _, keeper, sk, _ := CreateTestInputDefault(t, false, 100)
@@ -153,6 +166,13 @@ func TestQueries(t *testing.T) {
 	slashes = getQueriedValidatorSlashes(t, ctx, cdc, querier, valOpAddr1, 0, 10)
 	require.Equal(t, []types.ValidatorSlashEvent{slashOne, slashTwo}, slashes)
 
+	// test community pool query
+	feePool := keeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{{"token1", sdk.NewDecWithPrec(5, 1)}}
+	keeper.SetFeePool(ctx, feePool)
+	retCommunityPool := getQueriedCommunityPool(t, ctx, cdc, querier)
+	require.Equal(t, feePool.CommunityPool, retCommunityPool)
+
 	// test delegation rewards query
 	sh := staking.NewHandler(sk)
 	comm := staking.NewCommissionMsg(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
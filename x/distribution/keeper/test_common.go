@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -112,7 +113,13 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, isCheckTx, log.NewNopLogger())
 	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
-	ck := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	supplyKeeper := stakingkeeper.DummySupplyKeeper{}
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName: nil,
+		types.ModuleName:      nil,
+		staking.ModuleName:    {auth.Burner, auth.Staking},
+	}
+	ck := bank.NewBaseKeeper(accountKeeper, supplyKeeper, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, maccPerms)
 	sk := staking.NewKeeper(cdc, keyStaking, tkeyStaking, ck, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 	sk.SetPool(ctx, staking.InitialPool())
 	sk.SetParams(ctx, staking.DefaultParams())
@@ -128,6 +135,15 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 		sk.SetPool(ctx, pool)
 	}
 
+	// fund the distribution module account so that rewards allocated directly
+	// to validators in tests can be withdrawn
+	distrAcc := ck.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, distrAcc.SetCoins(sdk.NewCoins(
+		sdk.NewCoin(sk.GetParams(ctx).BondDenom, initCoins),
+		sdk.NewCoin("mytoken", initCoins),
+	)))
+	accountKeeper.SetAccount(ctx, distrAcc)
+
 	fck := DummyFeeCollectionKeeper{}
 	keeper := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), ck, sk, fck, types.DefaultCodespace)
 
@@ -0,0 +1,104 @@
+package distribution
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the distribution module.
+type AppModule struct {
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		keeper: keeper,
+	}
+}
+
+// Name returns the distribution module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the distribution module's types for the given codec
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterInvariants registers the distribution module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	RegisterInvariants(ir, am.keeper)
+}
+
+// Route returns the message routing key for the distribution module
+func (AppModule) Route() string {
+	return RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the distribution module
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// QuerierRoute returns the distribution module's querier route name
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// NewQuerierHandler returns the distribution module sdk.Querier
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the
+// distribution module
+func (AppModule) DefaultGenesis() json.RawMessage {
+	return types.MsgCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the distribution module
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := types.MsgCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// InitGenesis performs genesis initialization for the distribution module. It
+// returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	types.MsgCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the
+// distribution module
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return types.MsgCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the distribution module
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) sdk.Tags {
+	BeginBlocker(ctx, req, am.keeper)
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the distribution module. It returns no
+// validator updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
@@ -0,0 +1,37 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// NewCommunityPoolSpendProposalHandler returns the gov.Handler paying the
+// recipients of passed community pool spend proposals. The community pool may
+// not hold the amount anymore when the proposal passes, in which case the
+// proposal fails and nothing is paid, as it does if the recipient is a module
+// account.
+func NewCommunityPoolSpendProposalHandler(k keeper.Keeper) gov.Handler {
+	return func(ctx sdk.Context, content gov.ProposalContent) sdk.Error {
+		switch c := content.(type) {
+		case types.CommunityPoolSpendProposal:
+			return handleCommunityPoolSpendProposal(ctx, k, c)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized distr proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleCommunityPoolSpendProposal(ctx sdk.Context, k keeper.Keeper, p types.CommunityPoolSpendProposal) sdk.Error {
+	if err := k.DistributeFromFeePool(ctx, p.Amount, p.Recipient); err != nil {
+		return err
+	}
+
+	logger := ctx.Logger().With("module", "x/distribution")
+	logger.Info(fmt.Sprintf("paid %s to %s from the community pool", p.Amount, p.Recipient))
+	return nil
+}
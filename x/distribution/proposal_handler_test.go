@@ -0,0 +1,67 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+var recipient = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+func TestCommunityPoolSpendProposalValidateBasic(t *testing.T) {
+	amount := sdk.Coins{sdk.NewInt64Coin("stake", 1)}
+	tests := []struct {
+		proposal   types.CommunityPoolSpendProposal
+		expectPass bool
+	}{
+		{types.NewCommunityPoolSpendProposal("Test", "description", recipient, amount), true},
+		{types.NewCommunityPoolSpendProposal("", "description", recipient, amount), false},
+		{types.NewCommunityPoolSpendProposal("Test", "description", sdk.AccAddress{}, amount), false},
+		{types.NewCommunityPoolSpendProposal("Test", "description", recipient, sdk.Coins{}), false},
+		{types.NewCommunityPoolSpendProposal("Test", "description", recipient, sdk.Coins{sdk.NewInt64Coin("stake", 0)}), false},
+	}
+
+	for i, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.proposal.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, tc.proposal.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestCommunityPoolSpendProposalHandler(t *testing.T) {
+	ctx, ak, k, _, _ := keeper.CreateTestInputDefault(t, false, 10)
+	handler := NewCommunityPoolSpendProposalHandler(k)
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 1))}
+	k.SetFeePool(ctx, feePool)
+
+	// the recipient is paid from the community pool
+	amount := sdk.Coins{sdk.NewInt64Coin("stake", 1)}
+	proposal := types.NewCommunityPoolSpendProposal("Test", "description", recipient, amount)
+	require.NoError(t, handler(ctx, proposal))
+	require.Equal(t, amount, ak.GetAccount(ctx, recipient).GetCoins())
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1))},
+		k.GetFeePool(ctx).CommunityPool)
+
+	// module accounts cannot be paid
+	moduleProposal := types.NewCommunityPoolSpendProposal("Test", "description", auth.NewModuleAddress(auth.FeeCollectorName), amount)
+	err := handler(ctx, moduleProposal)
+	require.Error(t, err)
+	require.Equal(t, types.CodeModuleAccountRecipient, err.Code())
+
+	// the proposal fails once the community pool is insufficient
+	require.Error(t, handler(ctx, proposal))
+
+	// other proposal contents are not handled
+	require.Error(t, handler(ctx, gov.NewTextProposal("Test", "description")))
+}
@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,6 +15,8 @@ const (
 	CodeNoDistributionInfo      CodeType          = 104
 	CodeNoValidatorCommission   CodeType          = 105
 	CodeSetWithdrawAddrDisabled CodeType          = 106
+	CodeInsufficientFunds       CodeType          = 107
+	CodeModuleAccountRecipient  CodeType          = 108
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -36,3 +40,9 @@ func ErrNoValidatorCommission(codespace sdk.CodespaceType) sdk.Error {
 func ErrSetWithdrawAddrDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSetWithdrawAddrDisabled, "set withdraw address disabled")
 }
+func ErrInsufficientCommunityPool(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientFunds, "community pool does not have sufficient coins to distribute")
+}
+func ErrModuleAccountRecipient(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeModuleAccountRecipient, fmt.Sprintf("%s is a module account and cannot receive distributions", addr))
+}
@@ -20,7 +20,11 @@ type StakingKeeper interface {
 
 // expected coin keeper
 type BankKeeper interface {
-	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+	GetModuleAddress(name string) sdk.AccAddress
+	IsModuleAddress(ctx sdk.Context, addr sdk.AccAddress) bool
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
 }
 
 // expected fee collection keeper
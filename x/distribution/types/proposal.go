@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+const (
+	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
+	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+)
+
+// Assert CommunityPoolSpendProposal implements gov.ProposalContent at compile-time
+var _ gov.ProposalContent = CommunityPoolSpendProposal{}
+
+func init() {
+	gov.RegisterProposalTypeCodec(CommunityPoolSpendProposal{}, "gov/CommunityPoolSpendProposal")
+}
+
+// CommunityPoolSpendProposal spends from the community pool: it pays the
+// recipient from the community pool when it passes
+type CommunityPoolSpendProposal struct {
+	gov.TextProposal
+	Recipient sdk.AccAddress `json:"recipient"` //  Address receiving the amount
+	Amount    sdk.Coins      `json:"amount"`    //  Amount paid from the community pool
+}
+
+// NewCommunityPoolSpendProposal creates a new community pool spend proposal.
+func NewCommunityPoolSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins) CommunityPoolSpendProposal {
+	return CommunityPoolSpendProposal{
+		TextProposal: gov.NewTextProposal(title, description),
+		Recipient:    recipient,
+		Amount:       amount,
+	}
+}
+
+// ProposalRoute returns the routing key of a community pool spend proposal.
+func (csp CommunityPoolSpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool spend proposal.
+func (csp CommunityPoolSpendProposal) ProposalType() string { return ProposalTypeCommunityPoolSpend }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp CommunityPoolSpendProposal) ValidateBasic() sdk.Error {
+	if err := gov.ValidateAbstract(DefaultCodespace, csp); err != nil {
+		return err
+	}
+	if csp.Recipient.Empty() {
+		return sdk.ErrInvalidAddress(csp.Recipient.String())
+	}
+	if !csp.Amount.IsValid() || !csp.Amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins(csp.Amount.String())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (csp CommunityPoolSpendProposal) String() string {
+	return fmt.Sprintf(`Community Pool Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount)
+}
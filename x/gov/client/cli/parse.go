@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	govClientUtils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
@@ -40,3 +41,21 @@ func parseSubmitProposalFlags() (*proposal, error) {
 
 	return proposal, nil
 }
+
+// paramChangeProposal is the content of a parameter change proposal file
+type paramChangeProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Changes     []gov.ParamChange `json:"changes"`
+	Deposit     string            `json:"deposit"`
+}
+
+func parseParamChangeProposal(proposalFile string) (proposal paramChangeProposal, err error) {
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	err = json.Unmarshal(contents, &proposal)
+	return proposal, err
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -69,3 +71,35 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseParamChangeProposal(t *testing.T) {
+	okJSON, err := ioutil.TempFile("", "proposal")
+	require.Nil(t, err, "unexpected error")
+	okJSON.WriteString(`
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "changes": [
+    {
+      "subspace": "staking",
+      "key": "MaxValidators",
+      "value": "105"
+    }
+  ],
+  "deposit": "1000test"
+}
+`)
+
+	_, err = parseParamChangeProposal("fileDoesNotExist")
+	require.Error(t, err)
+
+	proposal, err := parseParamChangeProposal(okJSON.Name())
+	require.Nil(t, err, "unexpected error")
+	require.Equal(t, "Test Proposal", proposal.Title)
+	require.Equal(t, "My awesome proposal", proposal.Description)
+	require.Equal(t, []gov.ParamChange{gov.NewParamChange("staking", "MaxValidators", "105")}, proposal.Changes)
+	require.Equal(t, "1000test", proposal.Deposit)
+
+	err = okJSON.Close()
+	require.Nil(t, err, "unexpected error")
+}
@@ -46,12 +46,13 @@ var proposalFlags = []string{
 }
 
 // GetCmdSubmitProposal implements submitting a proposal transaction command.
+// Proposals of other types than text are submitted with its subcommands.
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-proposal",
 		Short: "Submit a proposal along with an initial deposit",
 		Long: strings.TrimSpace(`
-Submit a proposal along with an initial deposit. Proposal title, description, type and deposit can be given directly or through a proposal JSON file. For example:
+Submit a text proposal along with an initial deposit. Proposal title, description, type and deposit can be given directly or through a proposal JSON file. For example:
 
 $ gaiacli gov submit-proposal --proposal="path/to/proposal.json" --from mykey
 
@@ -67,6 +68,8 @@ where proposal.json contains:
 is equivalent to
 
 $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Proposals of other types are submitted with the subcommand of their type.
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			proposal, err := parseSubmitProposalFlags()
@@ -99,12 +102,12 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 				return fmt.Errorf("address %s doesn't have enough coins to pay for this transaction", from)
 			}
 
-			proposalType, err := gov.ProposalTypeFromString(proposal.Type)
-			if err != nil {
-				return err
+			if govClientUtils.NormalizeProposalType(proposal.Type) != gov.ProposalTypeText {
+				return fmt.Errorf("'%s' is not a valid proposal type; proposals of other types are submitted with the subcommand of their type", proposal.Type)
 			}
 
-			msg := gov.NewMsgSubmitProposal(proposal.Title, proposal.Description, proposalType, from, amount)
+			content := gov.NewTextProposal(proposal.Title, proposal.Description)
+			msg := gov.NewMsgSubmitProposal(content, from, amount)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -116,13 +119,68 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 
 	cmd.Flags().String(flagTitle, "", "title of proposal")
 	cmd.Flags().String(flagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(flagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
 	return cmd
 }
 
+// GetCmdSubmitParamChangeProposal implements submitting a parameter change
+// proposal transaction command.
+func GetCmdSubmitParamChangeProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a parameter change proposal",
+		Long: strings.TrimSpace(`
+Submit a parameter change proposal along with an initial deposit. The proposal is given through a proposal JSON file, listing the changed parameters with their new JSON encoded values:
+
+$ gaiacli tx gov submit-proposal param-change path/to/proposal.json --from mykey
+
+where proposal.json contains:
+
+{
+  "title": "Staking Param Change",
+  "description": "Update max validators",
+  "changes": [
+    {
+      "subspace": "staking",
+      "key": "MaxValidators",
+      "value": "105"
+    }
+  ],
+  "deposit": "10test"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := parseParamChangeProposal(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := gov.NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes)
+			msg := gov.NewMsgSubmitProposal(content, from, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+}
+
 // GetCmdDeposit implements depositing tokens for an active proposal.
 func GetCmdDeposit(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -11,12 +11,15 @@ import (
 
 // ModuleClient exports all client functionality from this module
 type ModuleClient struct {
-	storeKey string
-	cdc      *amino.Codec
+	storeKey     string
+	cdc          *amino.Codec
+	proposalCmds []*cobra.Command
 }
 
-func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
-	return ModuleClient{storeKey, cdc}
+// NewModuleClient creates the gov module client. The commands submitting the
+// proposal types of other modules are added as subcommands of submit-proposal.
+func NewModuleClient(storeKey string, cdc *amino.Codec, proposalCmds ...*cobra.Command) ModuleClient {
+	return ModuleClient{storeKey, cdc, proposalCmds}
 }
 
 // GetQueryCmd returns the cli query commands for this module
@@ -49,10 +52,14 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 		Short: "Governance transactions subcommands",
 	}
 
+	submitProposalCmd := govCli.GetCmdSubmitProposal(mc.cdc)
+	proposalCmds := append([]*cobra.Command{govCli.GetCmdSubmitParamChangeProposal(mc.cdc)}, mc.proposalCmds...)
+	submitProposalCmd.AddCommand(client.PostCommands(proposalCmds...)...)
+
 	govTxCmd.AddCommand(client.PostCommands(
 		govCli.GetCmdDeposit(mc.storeKey, mc.cdc),
 		govCli.GetCmdVote(mc.storeKey, mc.cdc),
-		govCli.GetCmdSubmitProposal(mc.cdc),
+		submitProposalCmd,
 	)...)
 
 	return govTxCmd
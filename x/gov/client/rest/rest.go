@@ -28,9 +28,20 @@ const (
 	RestNumLimit       = "limit"
 )
 
+// ProposalRESTHandler defines a REST handler submitting the proposals of a
+// proposal type of another module, under /gov/proposals/{SubRoute}
+type ProposalRESTHandler struct {
+	SubRoute string
+	Handler  func(http.ResponseWriter, *http.Request)
+}
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, phs ...ProposalRESTHandler) {
 	r.HandleFunc("/gov/proposals", postProposalHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/gov/proposals/param_change", postParamChangeProposalHandlerFn(cdc, cliCtx)).Methods("POST")
+	for _, ph := range phs {
+		r.HandleFunc(fmt.Sprintf("/gov/proposals/%s", ph.SubRoute), ph.Handler).Methods("POST")
+	}
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cdc, cliCtx)).Methods("POST")
 
@@ -57,11 +68,22 @@ type PostProposalReq struct {
 	BaseReq        rest.BaseReq   `json:"base_req"`
 	Title          string         `json:"title"`           // Title of the proposal
 	Description    string         `json:"description"`     // Description of the proposal
-	ProposalType   string         `json:"proposal_type"`   // Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	ProposalType   string         `json:"proposal_type"`   // Type of proposal, only Text; other proposal types have their own routes
 	Proposer       sdk.AccAddress `json:"proposer"`        // Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit"` // Coins to add to the proposal's deposit
 }
 
+// ParamChangeProposalReq defines the properties of a parameter change
+// proposal request's body.
+type ParamChangeProposalReq struct {
+	BaseReq        rest.BaseReq      `json:"base_req"`
+	Title          string            `json:"title"`           // Title of the proposal
+	Description    string            `json:"description"`     // Description of the proposal
+	Changes        []gov.ParamChange `json:"changes"`         // Parameter changes applied when the proposal passes
+	Proposer       sdk.AccAddress    `json:"proposer"`        // Address of the proposer
+	InitialDeposit sdk.Coins         `json:"initial_deposit"` // Coins to add to the proposal's deposit
+}
+
 // DepositReq defines the properties of a deposit request's body.
 type DepositReq struct {
 	BaseReq   rest.BaseReq   `json:"base_req"`
@@ -88,14 +110,39 @@ func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 			return
 		}
 
-		proposalType, err := gov.ProposalTypeFromString(govClientUtils.NormalizeProposalType(req.ProposalType))
-		if err != nil {
+		proposalType := govClientUtils.NormalizeProposalType(req.ProposalType)
+		if proposalType != gov.ProposalTypeText {
+			err := fmt.Errorf("'%s' is not a valid proposal type", req.ProposalType)
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
 		// create the message
-		msg := gov.NewMsgSubmitProposal(req.Title, req.Description, proposalType, req.Proposer, req.InitialDeposit)
+		content := gov.NewTextProposal(req.Title, req.Description)
+		msg := gov.NewMsgSubmitProposal(content, req.Proposer, req.InitialDeposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postParamChangeProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ParamChangeProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := gov.NewParameterChangeProposal(req.Title, req.Description, req.Changes)
+		msg := gov.NewMsgSubmitProposal(content, req.Proposer, req.InitialDeposit)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -187,6 +234,11 @@ func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc
 
 func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		paramType := vars[RestParamsType]
 
@@ -202,6 +254,11 @@ func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 
 func queryProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
 
@@ -236,6 +293,11 @@ func queryProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 
 func queryDepositsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
 
@@ -304,6 +366,11 @@ func queryProposerHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 
 func queryDepositHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
 		bechDepositorAddr := vars[RestDepositor]
@@ -378,6 +445,11 @@ func queryDepositHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 
 func queryVoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
 		bechVoterAddr := vars[RestVoter]
@@ -453,6 +525,11 @@ func queryVoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 // todo: Split this functionality into helper functions to remove the above
 func queryVotesOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
 
@@ -508,6 +585,11 @@ func queryVotesOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 // todo: Split this functionality into helper functions to remove the above
 func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bechVoterAddr := r.URL.Query().Get(RestVoter)
 		bechDepositorAddr := r.URL.Query().Get(RestDepositor)
 		strProposalStatus := r.URL.Query().Get(RestProposalStatus)
@@ -568,6 +650,11 @@ func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext)
 // todo: Split this functionality into helper functions to remove the above
 func queryTallyOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
 
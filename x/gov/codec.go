@@ -14,7 +14,16 @@ func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterInterface((*ProposalContent)(nil), nil)
 	cdc.RegisterConcrete(TextProposal{}, "gov/TextProposal", nil)
-	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "gov/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(ParameterChangeProposal{}, "gov/ParameterChangeProposal", nil)
+}
+
+// RegisterProposalTypeCodec registers the proposal content type of another
+// module on the gov codec, which encodes the sign bytes of MsgSubmitProposal.
+// Modules call it from their init; the type must be registered on the
+// application codec as well. Like the gov content types, proposal content
+// types are named "gov/<Type>".
+func RegisterProposalTypeCodec(o interface{}, name string) {
+	msgCdc.RegisterConcrete(o, name, nil)
 }
 
 func init() {
@@ -52,15 +52,31 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 		}
 		passes, tallyResults := tally(ctx, keeper, activeProposal)
 
-		var tagValue string
+		var tagValue, logMsg string
 		if passes {
 			keeper.RefundDeposits(ctx, activeProposal.ProposalID)
-			activeProposal.Status = StatusPassed
-			tagValue = tags.ActionProposalPassed
+
+			// The proposal handler may execute state mutating logic depending
+			// on the proposal content. It runs in a cached context: if it
+			// fails, the proposal fails and none of its state changes are
+			// written.
+			cacheCtx, writeCache := ctx.CacheContext()
+			err := applyProposal(cacheCtx, keeper, activeProposal.ProposalContent)
+			if err == nil {
+				activeProposal.Status = StatusPassed
+				tagValue = tags.ActionProposalPassed
+				logMsg = "passed"
+				writeCache()
+			} else {
+				activeProposal.Status = StatusFailed
+				tagValue = tags.ActionProposalFailed
+				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err.ABCILog())
+			}
 		} else {
 			keeper.DeleteDeposits(ctx, activeProposal.ProposalID)
 			activeProposal.Status = StatusRejected
 			tagValue = tags.ActionProposalRejected
+			logMsg = "rejected"
 		}
 
 		activeProposal.FinalTallyResult = tallyResults
@@ -69,8 +85,8 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 		logger.Info(
 			fmt.Sprintf(
-				"proposal %d (%s) tallied; passed: %v",
-				activeProposal.ProposalID, activeProposal.GetTitle(), passes,
+				"proposal %d (%s) tallied; %s",
+				activeProposal.ProposalID, activeProposal.GetTitle(), logMsg,
 			),
 		)
 
@@ -80,3 +96,14 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 	return resTags
 }
+
+// runs the Handler routed to by the content of a passed proposal. The route
+// may have been removed from the router since the proposal was submitted,
+// e.g. by an upgrade, in which case the proposal cannot be applied.
+func applyProposal(ctx sdk.Context, keeper Keeper, content ProposalContent) sdk.Error {
+	if !keeper.router.HasRoute(content.ProposalRoute()) {
+		return ErrNoProposalHandlerExists(keeper.codespace, content)
+	}
+	handler := keeper.router.GetRoute(content.ProposalRoute())
+	return handler(ctx, content)
+}
@@ -9,6 +9,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestTickExpiredDepositPeriod(t *testing.T) {
@@ -25,7 +27,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 	require.False(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
 
-	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
+	newProposalMsg := NewMsgSubmitProposal(NewTextProposal("Test", "test"), addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -71,7 +73,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 	require.False(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
 
-	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
+	newProposalMsg := NewMsgSubmitProposal(NewTextProposal("Test", "test"), addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -88,7 +90,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 	require.False(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
 
-	newProposalMsg2 := NewMsgSubmitProposal("Test2", "test2", ProposalTypeText, addrs[1], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
+	newProposalMsg2 := NewMsgSubmitProposal(NewTextProposal("Test2", "test2"), addrs[1], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
 	res = govHandler(ctx, newProposalMsg2)
 	require.True(t, res.IsOK())
 
@@ -134,7 +136,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 	require.False(t, activeQueue.Valid())
 	activeQueue.Close()
 
-	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
+	newProposalMsg := NewMsgSubmitProposal(NewTextProposal("Test", "test"), addrs[0], sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)})
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -181,7 +183,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(5))}
-	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], proposalCoins)
+	newProposalMsg := NewMsgSubmitProposal(NewTextProposal("Test", "test"), addrs[0], proposalCoins)
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -222,3 +224,159 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.False(t, activeQueue.Valid())
 	activeQueue.Close()
 }
+
+// testContent is a proposal content routed to the test handler, which sends
+// coins and then fails if the content asks it to
+type testContent struct {
+	TextProposal
+	Fail bool `json:"fail"`
+}
+
+func (testContent) ProposalRoute() string { return "test" }
+
+func TestPassedProposalHandler(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.Cdc.RegisterConcrete(testContent{}, "gov/testContent", nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.ck.SetSendEnabled(ctx, true)
+	stakingHandler := staking.NewHandler(sk)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{10})
+	staking.EndBlocker(ctx, sk)
+
+	// content without a route cannot be submitted
+	_, err := keeper.SubmitProposal(ctx, testContent{TextProposal: testProposal()})
+	require.Error(t, err)
+	require.Equal(t, CodeNoProposalHandlerExists, err.Code())
+
+	amount := sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)}
+	keeper.router = NewRouter().AddRoute("test", func(ctx sdk.Context, content ProposalContent) sdk.Error {
+		_, err := keeper.ck.SendCoins(ctx, addrs[1], addrs[2], amount)
+		require.NoError(t, err)
+		if content.(testContent).Fail {
+			return sdk.ErrInternal("failed")
+		}
+		return nil
+	})
+
+	passProposal := func(ctx sdk.Context, content ProposalContent) (sdk.Context, Proposal) {
+		proposal, err := keeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+		keeper.activateVotingPeriod(ctx, proposal)
+		require.NoError(t, keeper.AddVote(ctx, proposal.ProposalID, addrs[0], OptionYes))
+
+		ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod))
+		EndBlocker(ctx, keeper)
+
+		proposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		return ctx, proposal
+	}
+
+	balance := keeper.ck.GetCoins(ctx, addrs[2])
+
+	// the state changes of the handler are written when it succeeds
+	ctx, proposal := passProposal(ctx, testContent{TextProposal: testProposal()})
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Equal(t, balance.Add(amount), keeper.ck.GetCoins(ctx, addrs[2]))
+
+	// and discarded when it fails
+	ctx, proposal = passProposal(ctx, testContent{TextProposal: testProposal(), Fail: true})
+	require.Equal(t, StatusFailed, proposal.Status)
+	require.Equal(t, balance.Add(amount), keeper.ck.GetCoins(ctx, addrs[2]))
+}
+
+func TestSubmitParameterChangeProposal(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.ck.SetSendEnabled(ctx, true)
+	govHandler := NewHandler(keeper)
+	deposit := sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)}
+
+	tests := []struct {
+		change     ParamChange
+		expectPass bool
+	}{
+		{NewParamChange(staking.DefaultParamspace, string(staking.KeyMaxValidators), "105"), true},
+		{NewParamChange("unknown", string(staking.KeyMaxValidators), "105"), false},
+		{NewParamChange(staking.DefaultParamspace, "unknown", "105"), false},
+		{NewParamChange(staking.DefaultParamspace, string(staking.KeyMaxValidators), `"abc"`), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitProposal(NewParameterChangeProposal("Test", "test", []ParamChange{tc.change}), addrs[0], deposit)
+		res := govHandler(ctx, msg)
+		if tc.expectPass {
+			require.True(t, res.IsOK(), "test: %v", i)
+		} else {
+			require.False(t, res.IsOK(), "test: %v", i)
+			require.Equal(t, CodeInvalidParamChange, res.Code, "test: %v", i)
+		}
+	}
+}
+
+func TestPassedParameterChangeProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(sk)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{10})
+	staking.EndBlocker(ctx, sk)
+
+	maxValidators := string(staking.KeyMaxValidators)
+	bondDenom := string(staking.KeyBondDenom)
+	sendEnabledDenoms := string(bank.ParamStoreKeySendEnabledDenoms)
+	passProposal := func(ctx sdk.Context, changes []ParamChange, status ProposalStatus) sdk.Context {
+		proposal, err := keeper.SubmitProposal(ctx, NewParameterChangeProposal("Test", "description", changes))
+		require.NoError(t, err)
+		keeper.activateVotingPeriod(ctx, proposal)
+		require.NoError(t, keeper.AddVote(ctx, proposal.ProposalID, addrs[0], OptionYes))
+
+		ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod))
+		EndBlocker(ctx, keeper)
+
+		proposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		require.Equal(t, status, proposal.Status)
+		return ctx
+	}
+
+	// the changes are applied when the proposal passes
+	ctx = passProposal(ctx, []ParamChange{
+		NewParamChange(staking.DefaultParamspace, maxValidators, "105"),
+		NewParamChange(staking.DefaultParamspace, bondDenom, `"stake2"`),
+		NewParamChange(bank.DefaultParamspace, sendEnabledDenoms, `[{"denom":"stake2","enabled":false}]`),
+	}, StatusPassed)
+	require.Equal(t, uint16(105), sk.MaxValidators(ctx))
+	require.Equal(t, "stake2", sk.BondDenom(ctx))
+	require.False(t, keeper.ck.(bank.Keeper).IsSendEnabled(ctx, "stake2"))
+
+	// no change is applied if any of them fails, which fails the proposal
+	ctx = passProposal(ctx, []ParamChange{
+		NewParamChange(staking.DefaultParamspace, maxValidators, "10"),
+		NewParamChange(staking.DefaultParamspace, bondDenom, "true"),
+	}, StatusFailed)
+	require.Equal(t, uint16(105), sk.MaxValidators(ctx))
+	require.Equal(t, "stake2", sk.BondDenom(ctx))
+
+	// parameters are validated before they are updated
+	ctx = passProposal(ctx, []ParamChange{
+		NewParamChange(bank.DefaultParamspace, sendEnabledDenoms,
+			`[{"denom":"stake2","enabled":true},{"denom":"stake2","enabled":false}]`),
+	}, StatusFailed)
+	require.False(t, keeper.ck.(bank.Keeper).IsSendEnabled(ctx, "stake2"))
+}
@@ -21,6 +21,9 @@ const (
 	CodeInvalidVote             sdk.CodeType = 9
 	CodeInvalidGenesis          sdk.CodeType = 10
 	CodeInvalidProposalStatus   sdk.CodeType = 11
+	CodeInvalidParamChange      sdk.CodeType = 12
+	CodeInvalidProposalContent  sdk.CodeType = 13
+	CodeNoProposalHandlerExists sdk.CodeType = 14
 )
 
 // Error constructors
@@ -53,7 +56,7 @@ func ErrInvalidDescription(codespace sdk.CodespaceType, errorMsg string) sdk.Err
 	return sdk.NewError(codespace, CodeInvalidDescription, errorMsg)
 }
 
-func ErrInvalidProposalType(codespace sdk.CodespaceType, proposalType ProposalKind) sdk.Error {
+func ErrInvalidProposalType(codespace sdk.CodespaceType, proposalType string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidProposalType, fmt.Sprintf("Proposal Type '%s' is not valid", proposalType))
 }
 
@@ -64,3 +67,15 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
+
+func ErrInvalidParamChange(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChange, fmt.Sprintf("Invalid parameter change: %s", msg))
+}
+
+func ErrInvalidProposalContent(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalContent, fmt.Sprintf("Invalid proposal content: %s", msg))
+}
+
+func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content ProposalContent) sdk.Error {
+	return sdk.NewError(codespace, CodeNoProposalHandlerExists, fmt.Sprintf("No handler exists for proposal route %s of proposal type %s", content.ProposalRoute(), content.ProposalType()))
+}
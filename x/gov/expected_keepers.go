@@ -1,6 +1,8 @@
 package gov
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // expected bank keeper
 type BankKeeper interface {
@@ -126,3 +126,34 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, ok)
 	require.True(t, proposal2.Status == StatusRejected)
 }
+
+func TestImportExportParameterChangeProposal(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 2, GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	changes := []ParamChange{NewParamChange("staking", "MaxValidators", "105")}
+	proposal, err := keeper.SubmitProposal(ctx, NewParameterChangeProposal("Test", "description", changes))
+	require.NoError(t, err)
+
+	genAccs := mapp.AccountKeeper.GetAllAccounts(ctx)
+
+	// Export the state through JSON and import it into a new Mock App
+	var genState GenesisState
+	keeper.cdc.MustUnmarshalJSON(keeper.cdc.MustMarshalJSON(ExportGenesis(ctx, keeper)), &genState)
+	mapp2, keeper2, _, _, _, _ := getMockApp(t, 2, genState, genAccs)
+
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp2.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx2 := mapp2.BaseApp.NewContext(false, abci.Header{})
+
+	proposal2, ok := keeper2.GetProposal(ctx2, proposal.ProposalID)
+	require.True(t, ok)
+	require.True(t, ProposalEqual(proposal, proposal2))
+	require.Equal(t, changes, proposal2.ProposalContent.(ParameterChangeProposal).Changes)
+	require.Equal(t, genState, ExportGenesis(ctx2, keeper2))
+}
@@ -25,16 +25,17 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
-	var content ProposalContent
-	switch msg.ProposalType {
-	case ProposalTypeText:
-		content = NewTextProposal(msg.Title, msg.Description)
-	case ProposalTypeSoftwareUpgrade:
-		content = NewSoftwareUpgradeProposal(msg.Title, msg.Description)
-	default:
-		return ErrInvalidProposalType(keeper.codespace, msg.ProposalType).Result()
+	// reject parameter changes that could never be applied before they are
+	// voted upon
+	if content, ok := msg.Content.(ParameterChangeProposal); ok {
+		for _, change := range content.Changes {
+			if err := validateParamChange(keeper.paramsKeeper, keeper.codespace, change); err != nil {
+				return err.Result()
+			}
+		}
 	}
-	proposal, err := keeper.SubmitProposal(ctx, content)
+
+	proposal, err := keeper.SubmitProposal(ctx, msg.Content)
 	if err != nil {
 		return err.Result()
 	}
@@ -72,15 +72,27 @@ type Keeper struct {
 
 	// Reserved codespace
 	codespace sdk.CodespaceType
+
+	// Proposal router
+	router Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
 // - submitting governance proposals
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
-// - and tallying the result of the vote.
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
-	paramSpace params.Subspace, ck BankKeeper, ds sdk.DelegationSet, codespace sdk.CodespaceType) Keeper {
+// - tallying the result of the vote
+// - and applying passed proposals through the Handler routed to by the router
+//
+// The router is sealed: all proposal routes must be added before the keeper is
+// created.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, paramSpace params.Subspace,
+	ck BankKeeper, ds sdk.DelegationSet, codespace sdk.CodespaceType, rtr Router) Keeper {
+
+	// it is vital to seal the governance proposal router here as to not allow
+	// further handlers to be registered after the keeper is created since this
+	// could create invalid or non-deterministic behavior.
+	rtr.Seal()
 
 	return Keeper{
 		storeKey:     key,
@@ -91,11 +103,16 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
 		vs:           ds.GetValidatorSet(),
 		cdc:          cdc,
 		codespace:    codespace,
+		router:       rtr,
 	}
 }
 
 // Proposals
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content ProposalContent) (proposal Proposal, err sdk.Error) {
+	if !keeper.router.HasRoute(content.ProposalRoute()) {
+		return proposal, ErrNoProposalHandlerExists(keeper.codespace, content)
+	}
+
 	proposalID, err := keeper.getNewProposalID(ctx)
 	if err != nil {
 		return
@@ -0,0 +1,101 @@
+package gov
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the gov module.
+type AppModule struct {
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		keeper: keeper,
+	}
+}
+
+// Name returns the gov module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the gov module's types for the given codec
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterInvariants registers the gov module invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// Route returns the message routing key for the gov module
+func (AppModule) Route() string {
+	return RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the gov module
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// QuerierRoute returns the gov module's querier route name
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// NewQuerierHandler returns the gov module sdk.Querier
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the gov
+// module
+func (AppModule) DefaultGenesis() json.RawMessage {
+	return msgCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the gov module
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := msgCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// InitGenesis performs genesis initialization for the gov module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	msgCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the gov
+// module
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return msgCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the gov module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the gov module. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	tags := EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}, tags
+}
@@ -20,18 +20,14 @@ var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
-	Title          string         `json:"title"`           //  Title of the proposal
-	Description    string         `json:"description"`     //  Description of the proposal
-	ProposalType   ProposalKind   `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
-	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
-	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
+	Content        ProposalContent `json:"content"`         //  Content of the proposal, routed to its Handler when it passes
+	Proposer       sdk.AccAddress  `json:"proposer"`        //  Address of the proposer
+	InitialDeposit sdk.Coins       `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
 }
 
-func NewMsgSubmitProposal(title, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
+func NewMsgSubmitProposal(content ProposalContent, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
 	return MsgSubmitProposal{
-		Title:          title,
-		Description:    description,
-		ProposalType:   proposalType,
+		Content:        content,
 		Proposer:       proposer,
 		InitialDeposit: initialDeposit,
 	}
@@ -43,20 +39,11 @@ func (msg MsgSubmitProposal) Type() string  { return TypeMsgSubmitProposal }
 
 // Implements Msg.
 func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
-	if len(msg.Title) == 0 {
-		return ErrInvalidTitle(DefaultCodespace, "No title present in proposal")
+	if msg.Content == nil {
+		return ErrInvalidProposalContent(DefaultCodespace, "missing content")
 	}
-	if len(msg.Title) > MaxTitleLength {
-		return ErrInvalidTitle(DefaultCodespace, fmt.Sprintf("Proposal title is longer than max length of %d", MaxTitleLength))
-	}
-	if len(msg.Description) == 0 {
-		return ErrInvalidDescription(DefaultCodespace, "No description present in proposal")
-	}
-	if len(msg.Description) > MaxDescriptionLength {
-		return ErrInvalidDescription(DefaultCodespace, fmt.Sprintf("Proposal description is longer than max length of %d", MaxDescriptionLength))
-	}
-	if !validProposalType(msg.ProposalType) {
-		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	if err := msg.Content.ValidateBasic(); err != nil {
+		return err
 	}
 	if msg.Proposer.Empty() {
 		return sdk.ErrInvalidAddress(msg.Proposer.String())
@@ -71,7 +58,7 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 }
 
 func (msg MsgSubmitProposal) String() string {
-	return fmt.Sprintf("MsgSubmitProposal{%s, %s, %s, %v}", msg.Title, msg.Description, msg.ProposalType, msg.InitialDeposit)
+	return fmt.Sprintf("MsgSubmitProposal{%s, %s, %v}", msg.Content.GetTitle(), msg.Content.ProposalType(), msg.InitialDeposit)
 }
 
 // Implements Msg.
@@ -26,26 +26,52 @@ func TestMsgSubmitProposal(t *testing.T) {
 	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.NewCoins())
 	tests := []struct {
 		title, description string
-		proposalType       ProposalKind
 		proposerAddr       sdk.AccAddress
 		initialDeposit     sdk.Coins
 		expectPass         bool
 	}{
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
-		{"", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeParameterChange, addrs[0], coinsPos, true},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, true},
-		{"Test Proposal", "the purpose of this proposal is to test", 0x05, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, true},
-		{strings.Repeat("#", MaxTitleLength*2), "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, false},
-		{"Test Proposal", strings.Repeat("#", MaxDescriptionLength*2), ProposalTypeText, addrs[0], coinsMulti, false},
+		{"Test Proposal", "the purpose of this proposal is to test", addrs[0], coinsPos, true},
+		{"", "the purpose of this proposal is to test", addrs[0], coinsPos, false},
+		{"Test Proposal", "", addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", sdk.AccAddress{}, coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", addrs[0], coinsZero, true},
+		{"Test Proposal", "the purpose of this proposal is to test", addrs[0], coinsMulti, true},
+		{strings.Repeat("#", MaxTitleLength*2), "the purpose of this proposal is to test", addrs[0], coinsMulti, false},
+		{"Test Proposal", strings.Repeat("#", MaxDescriptionLength*2), addrs[0], coinsMulti, false},
 	}
 
 	for i, tc := range tests {
-		msg := NewMsgSubmitProposal(tc.title, tc.description, tc.proposalType, tc.proposerAddr, tc.initialDeposit)
+		msg := NewMsgSubmitProposal(NewTextProposal(tc.title, tc.description), tc.proposerAddr, tc.initialDeposit)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	require.Error(t, NewMsgSubmitProposal(nil, addrs[0], coinsPos).ValidateBasic())
+}
+
+func TestMsgSubmitParameterChangeProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.NewCoins())
+	tests := []struct {
+		title      string
+		changes    []ParamChange
+		proposer   sdk.AccAddress
+		expectPass bool
+	}{
+		{"Test Proposal", []ParamChange{NewParamChange("staking", "MaxValidators", "105")}, addrs[0], true},
+		{"", []ParamChange{NewParamChange("staking", "MaxValidators", "105")}, addrs[0], false},
+		{"Test Proposal", []ParamChange{NewParamChange("staking", "MaxValidators", "105")}, sdk.AccAddress{}, false},
+		{"Test Proposal", nil, addrs[0], false},
+		{"Test Proposal", []ParamChange{NewParamChange("", "MaxValidators", "105")}, addrs[0], false},
+		{"Test Proposal", []ParamChange{NewParamChange("staking", "", "105")}, addrs[0], false},
+		{"Test Proposal", []ParamChange{NewParamChange("staking", "MaxValidators", "")}, addrs[0], false},
+	}
+
+	for i, tc := range tests {
+		content := NewParameterChangeProposal(tc.title, "the purpose of this proposal is to test", tc.changes)
+		msg := NewMsgSubmitProposal(content, tc.proposer, coinsPos)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
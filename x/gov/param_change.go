@@ -0,0 +1,141 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Proposal types
+const (
+	ProposalTypeParameterChange string = "ParameterChange"
+)
+
+// ParamChange is a change of the value of a parameter in the subspace of a
+// module, with the value JSON encoded as the parameter is in the params store
+type ParamChange struct {
+	Subspace string `json:"subspace"` //  Name of the parameter subspace, e.g. "staking"
+	Key      string `json:"key"`      //  Key of the parameter in the subspace
+	Value    string `json:"value"`    //  New JSON encoded value of the parameter
+}
+
+func NewParamChange(subspace, key, value string) ParamChange {
+	return ParamChange{
+		Subspace: subspace,
+		Key:      key,
+		Value:    value,
+	}
+}
+
+// ValidateBasic checks that all fields of the change are present
+func (pc ParamChange) ValidateBasic() sdk.Error {
+	if len(pc.Subspace) == 0 {
+		return ErrInvalidParamChange(DefaultCodespace, "subspace cannot be empty")
+	}
+	if len(pc.Key) == 0 {
+		return ErrInvalidParamChange(DefaultCodespace, "key cannot be empty")
+	}
+	if len(pc.Value) == 0 {
+		return ErrInvalidParamChange(DefaultCodespace, "value cannot be empty")
+	}
+	return nil
+}
+
+// nolint
+func (pc ParamChange) String() string {
+	return fmt.Sprintf("%s/%s: %s", pc.Subspace, pc.Key, pc.Value)
+}
+
+// Parameter Change Proposals, which apply their parameter changes when they
+// pass. They are routed to the params module, which cannot depend on gov.
+type ParameterChangeProposal struct {
+	TextProposal
+	Changes []ParamChange `json:"changes"` //  Parameter changes to apply
+}
+
+func NewParameterChangeProposal(title, description string, changes []ParamChange) ParameterChangeProposal {
+	return ParameterChangeProposal{
+		TextProposal: NewTextProposal(title, description),
+		Changes:      changes,
+	}
+}
+
+// Implements Proposal Interface
+var _ ProposalContent = ParameterChangeProposal{}
+
+// nolint
+func (pcp ParameterChangeProposal) ProposalRoute() string { return params.RouterKey }
+func (pcp ParameterChangeProposal) ProposalType() string  { return ProposalTypeParameterChange }
+
+// ValidateBasic checks the proposal fields and that it changes at least one
+// parameter
+func (pcp ParameterChangeProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, pcp); err != nil {
+		return err
+	}
+	if len(pcp.Changes) == 0 {
+		return ErrInvalidParamChange(DefaultCodespace, "no parameter changes")
+	}
+	for _, change := range pcp.Changes {
+		if err := change.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pcp ParameterChangeProposal) String() string {
+	out := fmt.Sprintf(`Parameter Change Proposal:
+  Title:       %s
+  Description: %s
+  Changes:
+`, pcp.Title, pcp.Description)
+	for _, change := range pcp.Changes {
+		out += fmt.Sprintf("    %s\n", change)
+	}
+	return out
+}
+
+// NewParamChangeProposalHandler returns the Handler applying passed parameter
+// change proposals. The changes are applied all or none: if any change fails,
+// e.g. because its subspace is no longer registered after an upgrade, the
+// proposal fails.
+func NewParamChangeProposalHandler(k params.Keeper) Handler {
+	return func(ctx sdk.Context, content ProposalContent) sdk.Error {
+		switch c := content.(type) {
+		case ParameterChangeProposal:
+			return handleParameterChangeProposal(ctx, k, c)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized param proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleParameterChangeProposal(ctx sdk.Context, k params.Keeper, p ParameterChangeProposal) sdk.Error {
+	logger := ctx.Logger().With("module", "x/gov")
+	for _, change := range p.Changes {
+		subspace, ok := k.GetSubspace(change.Subspace)
+		if !ok {
+			return ErrInvalidParamChange(DefaultCodespace, fmt.Sprintf("unknown subspace %s", change.Subspace))
+		}
+		if err := subspace.Update(ctx, []byte(change.Key), []byte(change.Value)); err != nil {
+			return ErrInvalidParamChange(DefaultCodespace, err.Error())
+		}
+		logger.Info(fmt.Sprintf("changed parameter %s", change))
+	}
+	return nil
+}
+
+// validates a parameter change against the key table of its subspace
+func validateParamChange(k params.Keeper, codespace sdk.CodespaceType, change ParamChange) sdk.Error {
+	subspace, ok := k.GetSubspace(change.Subspace)
+	if !ok {
+		return ErrInvalidParamChange(codespace, fmt.Sprintf("unknown subspace %s", change.Subspace))
+	}
+	if err := subspace.Validate([]byte(change.Key), []byte(change.Value)); err != nil {
+		return ErrInvalidParamChange(codespace, err.Error())
+	}
+	return nil
+}
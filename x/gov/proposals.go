@@ -16,7 +16,7 @@ type Proposal struct {
 
 	ProposalID uint64 `json:"proposal_id"` //  ID of the proposal
 
-	Status           ProposalStatus `json:"proposal_status"`    //  Status of the Proposal {Pending, Active, Passed, Rejected}
+	Status           ProposalStatus `json:"proposal_status"`    //  Status of the Proposal {Pending, Active, Passed, Rejected, Failed}
 	FinalTallyResult TallyResult    `json:"final_tally_result"` //  Result of Tallys
 
 	SubmitTime     time.Time `json:"submit_time"`      //  Time of the block where TxGovSubmitProposal was included
@@ -44,14 +44,22 @@ func (p Proposal) String() string {
 
 // ProposalContent is an interface that has title, description, and proposaltype
 // that the governance module can use to identify them and generate human readable messages
-// ProposalContent can have additional fields, which will handled by ProposalHandlers
-// via type assertion, e.g. parameter change amount in ParameterChangeProposal
+// ProposalContent can have additional fields, which will handled by the Handler
+// registered for its route, e.g. parameter change amount in ParameterChangeProposal
 type ProposalContent interface {
 	GetTitle() string
 	GetDescription() string
-	ProposalType() ProposalKind
+	ProposalRoute() string
+	ProposalType() string
+	ValidateBasic() sdk.Error
+	String() string
 }
 
+// Handler applies the content of a passed proposal. It is run in a cached
+// context: if it returns an error, the proposal fails and none of its state
+// changes are written.
+type Handler func(ctx sdk.Context, content ProposalContent) sdk.Error
+
 // Proposals is an array of proposal
 type Proposals []Proposal
 
@@ -66,6 +74,11 @@ func (p Proposals) String() string {
 	return strings.TrimSpace(out)
 }
 
+// Proposal types
+const (
+	ProposalTypeText string = "Text"
+)
+
 // Text Proposals
 type TextProposal struct {
 	Title       string `json:"title"`       //  Title of the proposal
@@ -83,124 +96,62 @@ func NewTextProposal(title, description string) TextProposal {
 var _ ProposalContent = TextProposal{}
 
 // nolint
-func (tp TextProposal) GetTitle() string           { return tp.Title }
-func (tp TextProposal) GetDescription() string     { return tp.Description }
-func (tp TextProposal) ProposalType() ProposalKind { return ProposalTypeText }
-
-// Software Upgrade Proposals
-type SoftwareUpgradeProposal struct {
-	TextProposal
+func (tp TextProposal) GetTitle() string         { return tp.Title }
+func (tp TextProposal) GetDescription() string   { return tp.Description }
+func (tp TextProposal) ProposalRoute() string    { return RouterKey }
+func (tp TextProposal) ProposalType() string     { return ProposalTypeText }
+func (tp TextProposal) ValidateBasic() sdk.Error { return ValidateAbstract(DefaultCodespace, tp) }
+
+func (tp TextProposal) String() string {
+	return fmt.Sprintf(`Text Proposal:
+  Title:       %s
+  Description: %s
+`, tp.Title, tp.Description)
 }
 
-func NewSoftwareUpgradeProposal(title, description string) SoftwareUpgradeProposal {
-	return SoftwareUpgradeProposal{
-		TextProposal: NewTextProposal(title, description),
+// ValidateAbstract validates the fields shared by all proposal contents. It
+// is meant to be called by the ValidateBasic of the content types of other
+// modules.
+func ValidateAbstract(codespace sdk.CodespaceType, c ProposalContent) sdk.Error {
+	title := c.GetTitle()
+	if len(strings.TrimSpace(title)) == 0 {
+		return ErrInvalidTitle(codespace, "No title present in proposal")
 	}
-}
-
-// Implements Proposal Interface
-var _ ProposalContent = SoftwareUpgradeProposal{}
-
-// nolint
-func (sup SoftwareUpgradeProposal) ProposalType() ProposalKind { return ProposalTypeSoftwareUpgrade }
-
-// ProposalQueue
-type ProposalQueue []uint64
-
-// ProposalKind
-
-// Type that represents Proposal Type as a byte
-type ProposalKind byte
-
-//nolint
-const (
-	ProposalTypeNil             ProposalKind = 0x00
-	ProposalTypeText            ProposalKind = 0x01
-	ProposalTypeParameterChange ProposalKind = 0x02
-	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
-)
-
-// String to proposalType byte. Returns 0xff if invalid.
-func ProposalTypeFromString(str string) (ProposalKind, error) {
-	switch str {
-	case "Text":
-		return ProposalTypeText, nil
-	case "ParameterChange":
-		return ProposalTypeParameterChange, nil
-	case "SoftwareUpgrade":
-		return ProposalTypeSoftwareUpgrade, nil
-	default:
-		return ProposalKind(0xff), fmt.Errorf("'%s' is not a valid proposal type", str)
+	if len(title) > MaxTitleLength {
+		return ErrInvalidTitle(codespace, fmt.Sprintf("Proposal title is longer than max length of %d", MaxTitleLength))
 	}
-}
 
-// is defined ProposalType?
-func validProposalType(pt ProposalKind) bool {
-	if pt == ProposalTypeText ||
-		pt == ProposalTypeParameterChange ||
-		pt == ProposalTypeSoftwareUpgrade {
-		return true
+	description := c.GetDescription()
+	if len(description) == 0 {
+		return ErrInvalidDescription(codespace, "No description present in proposal")
 	}
-	return false
-}
-
-// Marshal needed for protobuf compatibility
-func (pt ProposalKind) Marshal() ([]byte, error) {
-	return []byte{byte(pt)}, nil
-}
-
-// Unmarshal needed for protobuf compatibility
-func (pt *ProposalKind) Unmarshal(data []byte) error {
-	*pt = ProposalKind(data[0])
-	return nil
-}
-
-// Marshals to JSON using string
-func (pt ProposalKind) MarshalJSON() ([]byte, error) {
-	return json.Marshal(pt.String())
-}
-
-// Unmarshals from JSON assuming Bech32 encoding
-func (pt *ProposalKind) UnmarshalJSON(data []byte) error {
-	var s string
-	err := json.Unmarshal(data, &s)
-	if err != nil {
-		return err
+	if len(description) > MaxDescriptionLength {
+		return ErrInvalidDescription(codespace, fmt.Sprintf("Proposal description is longer than max length of %d", MaxDescriptionLength))
 	}
 
-	bz2, err := ProposalTypeFromString(s)
-	if err != nil {
-		return err
+	if len(c.ProposalRoute()) == 0 {
+		return ErrInvalidProposalContent(codespace, "proposal route cannot be empty")
+	}
+	if len(c.ProposalType()) == 0 {
+		return ErrInvalidProposalType(codespace, c.ProposalType())
 	}
-	*pt = bz2
 	return nil
 }
 
-// Turns VoteOption byte to String
-func (pt ProposalKind) String() string {
-	switch pt {
-	case ProposalTypeText:
-		return "Text"
-	case ProposalTypeParameterChange:
-		return "ParameterChange"
-	case ProposalTypeSoftwareUpgrade:
-		return "SoftwareUpgrade"
+// ProposalHandler is the Handler of the proposal contents of the gov module.
+// Text proposals have no effect when they pass.
+func ProposalHandler(_ sdk.Context, content ProposalContent) sdk.Error {
+	switch content.(type) {
+	case TextProposal:
+		return nil
 	default:
-		return ""
+		errMsg := fmt.Sprintf("Unrecognized gov proposal content type: %T", content)
+		return sdk.ErrUnknownRequest(errMsg)
 	}
 }
 
-// For Printf / Sprintf, returns bech32 when using %s
-// nolint: errcheck
-func (pt ProposalKind) Format(s fmt.State, verb rune) {
-	switch verb {
-	case 's':
-		s.Write([]byte(pt.String()))
-	default:
-		// TODO: Do this conversion more directly
-		s.Write([]byte(fmt.Sprintf("%v", byte(pt))))
-	}
-}
+// ProposalQueue
+type ProposalQueue []uint64
 
 // ProposalStatus
 
@@ -214,6 +165,7 @@ const (
 	StatusVotingPeriod  ProposalStatus = 0x02
 	StatusPassed        ProposalStatus = 0x03
 	StatusRejected      ProposalStatus = 0x04
+	StatusFailed        ProposalStatus = 0x05
 )
 
 // ProposalStatusToString turns a string into a ProposalStatus
@@ -227,6 +179,8 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 		return StatusPassed, nil
 	case "Rejected":
 		return StatusRejected, nil
+	case "Failed":
+		return StatusFailed, nil
 	case "":
 		return StatusNil, nil
 	default:
@@ -239,7 +193,8 @@ func validProposalStatus(status ProposalStatus) bool {
 	if status == StatusDepositPeriod ||
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
-		status == StatusRejected {
+		status == StatusRejected ||
+		status == StatusFailed {
 		return true
 	}
 	return false
@@ -288,6 +243,8 @@ func (status ProposalStatus) String() string {
 		return "Passed"
 	case StatusRejected:
 		return "Rejected"
+	case StatusFailed:
+		return "Failed"
 	default:
 		return ""
 	}
@@ -287,3 +287,73 @@ func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+// ProvableQueries returns the store keys backing the governance queries of a
+// single proposal, deposit or vote by query path, see sdk.ProvableQuery.
+func ProvableQueries(cdc *codec.Codec) map[string]sdk.ProvableQuery {
+	route := func(query string) string {
+		return fmt.Sprintf("custom/%s/%s", QuerierRoute, query)
+	}
+
+	return map[string]sdk.ProvableQuery{
+		route(QueryProposal): sdk.NewSingleKeyProvableQuery(cdc, StoreKey,
+			func(data []byte) (key []byte, err error) {
+				var params QueryProposalParams
+				err = cdc.UnmarshalJSON(data, &params)
+				return KeyProposal(params.ProposalID), err
+			},
+			func(data, value []byte) (interface{}, sdk.Error) {
+				var params QueryProposalParams
+				cdc.MustUnmarshalJSON(data, &params)
+				if len(value) == 0 {
+					return nil, ErrUnknownProposal(DefaultCodespace, params.ProposalID)
+				}
+				var proposal Proposal
+				if err := decodeValue(cdc, value, &proposal); err != nil {
+					return nil, err
+				}
+				return proposal, nil
+			},
+		),
+		route(QueryDeposit): sdk.NewSingleKeyProvableQuery(cdc, StoreKey,
+			func(data []byte) (key []byte, err error) {
+				var params QueryDepositParams
+				err = cdc.UnmarshalJSON(data, &params)
+				return KeyDeposit(params.ProposalID, params.Depositor), err
+			},
+			func(_, value []byte) (interface{}, sdk.Error) {
+				var deposit Deposit
+				if err := decodeValue(cdc, value, &deposit); err != nil {
+					return nil, err
+				}
+				return deposit, nil
+			},
+		),
+		route(QueryVote): sdk.NewSingleKeyProvableQuery(cdc, StoreKey,
+			func(data []byte) (key []byte, err error) {
+				var params QueryVoteParams
+				err = cdc.UnmarshalJSON(data, &params)
+				return KeyVote(params.ProposalID, params.Voter), err
+			},
+			func(_, value []byte) (interface{}, sdk.Error) {
+				var vote Vote
+				if err := decodeValue(cdc, value, &vote); err != nil {
+					return nil, err
+				}
+				return vote, nil
+			},
+		),
+	}
+}
+
+// decodeValue decodes a store value into ptr, leaving it unchanged if the
+// value is empty.
+func decodeValue(cdc *codec.Codec, value []byte, ptr interface{}) sdk.Error {
+	if len(value) == 0 {
+		return nil
+	}
+	if err := cdc.UnmarshalBinaryLengthPrefixed(value, ptr); err != nil {
+		return sdk.ErrInternal(sdk.AppendMsgToErr("failed to decode value", err.Error()))
+	}
+	return nil
+}
@@ -187,16 +187,16 @@ func testQueries(t *testing.T) {
 	depositParams, _, _ := getQueriedParams(t, ctx, cdc, querier)
 
 	// addrs[0] proposes (and deposits) proposals #1 and #2
-	res := handler(ctx, NewMsgSubmitProposal("title", "description", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
+	res := handler(ctx, NewMsgSubmitProposal(NewTextProposal("title", "description"), addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
 	var proposalID1 uint64
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID1)
 
-	res = handler(ctx, NewMsgSubmitProposal("title", "description", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
+	res = handler(ctx, NewMsgSubmitProposal(NewTextProposal("title", "description"), addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
 	var proposalID2 uint64
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID2)
 
 	// addrs[1] proposes (and deposits) proposals #3
-	res = handler(ctx, NewMsgSubmitProposal("title", "description", ProposalTypeText, addrs[1], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
+	res = handler(ctx, NewMsgSubmitProposal(NewTextProposal("title", "description"), addrs[1], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
 	var proposalID3 uint64
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID3)
 
@@ -288,3 +288,54 @@ func testQueries(t *testing.T) {
 	tally := getQueriedTally(t, ctx, cdc, querier, proposalID2)
 	require.True(t, !tally.Equals(EmptyTallyResult()))
 }
+
+func TestProvableQueries(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2, GenesisState{}, nil)
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	querier := NewQuerier(keeper)
+	queries := ProvableQueries(keeper.cdc)
+
+	proposal, err := keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	deposit := Deposit{addrs[0], proposal.ProposalID, sdk.NewCoins(sdk.NewInt64Coin("dummycoin", 1))}
+	keeper.setDeposit(ctx, proposal.ProposalID, addrs[0], deposit)
+	keeper.setVote(ctx, proposal.ProposalID, addrs[1], Vote{addrs[1], proposal.ProposalID, OptionYes})
+
+	tests := []struct {
+		query string
+		data  interface{}
+	}{
+		{QueryProposal, NewQueryProposalParams(proposal.ProposalID)},
+		{QueryProposal, NewQueryProposalParams(proposal.ProposalID + 1)},
+		{QueryDeposit, NewQueryDepositParams(proposal.ProposalID, addrs[0])},
+		{QueryDeposit, NewQueryDepositParams(proposal.ProposalID, addrs[1])},
+		{QueryVote, NewQueryVoteParams(proposal.ProposalID, addrs[1])},
+		{QueryVote, NewQueryVoteParams(proposal.ProposalID, addrs[0])},
+	}
+
+	for i, tc := range tests {
+		query, ok := queries[strings.Join([]string{custom, QuerierRoute, tc.query}, "/")]
+		require.True(t, ok, tc.query)
+		require.Equal(t, StoreKey, query.Store)
+
+		// the result rebuilt from the values of the keys matches the querier's
+		req := abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(tc.data)}
+		keys, err := query.Keys(req.Data)
+		require.NoError(t, err)
+		values := make([][]byte, len(keys))
+		for j, key := range keys {
+			values[j] = ctx.KVStore(keeper.storeKey).Get(key)
+		}
+
+		expRes, expErr := querier(ctx, []string{tc.query}, req)
+		res, sdkErr := query.Result(req.Data, values)
+		require.Equal(t, expErr == nil, sdkErr == nil, "test %d", i)
+		if expErr != nil {
+			require.Equal(t, expErr.Code(), sdkErr.Code(), "test %d", i)
+			continue
+		}
+		require.Equal(t, string(expRes), string(res), "test %d", i)
+	}
+}
@@ -0,0 +1,69 @@
+package gov
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var isAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
+
+// Router routes the content of passed proposals to the Handler of their
+// module, by the ProposalRoute of the content.
+type Router interface {
+	AddRoute(r string, h Handler) (rtr Router)
+	HasRoute(r string) bool
+	GetRoute(path string) (h Handler)
+	Seal()
+}
+
+type router struct {
+	routes map[string]Handler
+	sealed bool
+}
+
+// NewRouter returns a new governance Router. Routes can be added until the
+// router is sealed by the governance Keeper it is given to.
+func NewRouter() Router {
+	return &router{
+		routes: make(map[string]Handler),
+	}
+}
+
+// Seal prevents the router from adding any more routes
+func (rtr *router) Seal() {
+	if rtr.sealed {
+		panic("router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// AddRoute adds a governance Handler for a given route path. The route must
+// be alphanumeric.
+func (rtr *router) AddRoute(path string, h Handler) Router {
+	if rtr.sealed {
+		panic("router sealed; cannot add route handler")
+	}
+	if !isAlphaNumeric(path) {
+		panic("route expressions can only contain alphanumeric characters")
+	}
+	if rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route %s has already been initialized", path))
+	}
+
+	rtr.routes[path] = h
+	return rtr
+}
+
+// HasRoute returns true if the router has a Handler for the given route path
+func (rtr *router) HasRoute(path string) bool {
+	return rtr.routes[path] != nil
+}
+
+// GetRoute returns the Handler for a given route path
+func (rtr *router) GetRoute(path string) Handler {
+	if !rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route \"%s\" does not exist", path))
+	}
+
+	return rtr.routes[path]
+}
@@ -0,0 +1,26 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter(t *testing.T) {
+	rtr := NewRouter()
+	require.False(t, rtr.HasRoute(RouterKey))
+	require.Panics(t, func() { rtr.GetRoute(RouterKey) })
+
+	rtr.AddRoute(RouterKey, ProposalHandler)
+	require.True(t, rtr.HasRoute(RouterKey))
+	require.NotNil(t, rtr.GetRoute(RouterKey))
+
+	// routes must be alphanumeric and unique
+	require.Panics(t, func() { rtr.AddRoute("gov/text", ProposalHandler) })
+	require.Panics(t, func() { rtr.AddRoute(RouterKey, ProposalHandler) })
+
+	// no routes can be added once the router is sealed
+	rtr.Seal()
+	require.Panics(t, func() { rtr.AddRoute("other", ProposalHandler) })
+	require.Panics(t, func() { rtr.Seal() })
+}
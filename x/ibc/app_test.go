@@ -15,23 +15,34 @@ import (
 )
 
 // initialize the mock application for this module
-func getMockApp(t *testing.T) *mock.App {
+func getMockApp(t *testing.T) (*mock.App, Mapper) {
 	mapp := mock.NewApp()
 
 	RegisterCodec(mapp.Cdc)
 	keyIBC := sdk.NewKVStoreKey("ibc")
 	ibcMapper := NewMapper(mapp.Cdc, keyIBC, DefaultCodespace)
-	bankKeeper := bank.NewBaseKeeper(mapp.AccountKeeper,
+	bankKeeper := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.SupplyKeeper,
 		mapp.ParamsKeeper.Subspace(bank.DefaultParamspace),
-		bank.DefaultCodespace)
+		bank.DefaultCodespace, maccPerms)
 	mapp.Router().AddRoute("ibc", NewHandler(ibcMapper, bankKeeper))
 
 	require.NoError(t, mapp.CompleteSetup(keyIBC))
-	return mapp
+	return mapp, ibcMapper
+}
+
+// createClient commits a block creating the light client of a chain, as a
+// passed create client proposal would.
+func createClient(mapp *mock.App, ibcMapper Mapper, header Header) sdk.Error {
+	blockHeader := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: blockHeader})
+	err := ibcMapper.CreateClient(mapp.BaseApp.NewContext(false, blockHeader), header)
+	mapp.EndBlock(abci.RequestEndBlock{})
+	mapp.Commit()
+	return err
 }
 
 func TestIBCMsgs(t *testing.T) {
-	mapp := getMockApp(t)
+	mapp, ibcMapper := getMockApp(t)
 
 	sourceChain := "source-chain"
 	destChain := "dest-chain"
@@ -55,34 +66,44 @@ func TestIBCMsgs(t *testing.T) {
 	require.Equal(t, acc, res1)
 
 	packet := IBCPacket{
-		SrcAddr:   addr1,
-		DestAddr:  addr1,
-		Coins:     coins,
-		SrcChain:  sourceChain,
-		DestChain: destChain,
+		SrcAddr:       addr1,
+		DestAddr:      addr1,
+		Coins:         coins,
+		SrcChain:      sourceChain,
+		DestChain:     destChain,
+		TimeoutHeight: 100,
 	}
 
 	transferMsg := MsgIBCTransfer{
 		IBCPacket: packet,
 	}
 
+	// the mock app runs with an empty chain ID
+	source := newTestSourceChain(mapp.Cdc, sourceChain, "ibc")
+	packet.DestChain = ""
+	proof, header := source.send(t, packet)
+
 	receiveMsg := MsgIBCReceive{
 		IBCPacket: packet,
+		Proof:     proof,
+		Header:    header,
 		Relayer:   addr1,
 		Sequence:  0,
 	}
 
-	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{transferMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+	blockHeader := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, blockHeader, []sdk.Msg{transferMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
 	mock.CheckBalance(t, mapp, addr1, emptyCoins)
 
-	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{transferMsg}, []uint64{0}, []uint64{1}, false, false, priv1)
+	blockHeader = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, blockHeader, []sdk.Msg{transferMsg}, []uint64{0}, []uint64{1}, false, false, priv1)
+
+	require.Nil(t, createClient(mapp, ibcMapper, source.header(1, nil)))
 
-	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{receiveMsg}, []uint64{0}, []uint64{2}, true, true, priv1)
-	mock.CheckBalance(t, mapp, addr1, coins)
+	blockHeader = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, blockHeader, []sdk.Msg{receiveMsg}, []uint64{0}, []uint64{2}, true, true, priv1)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin(VoucherDenom(sourceChain, "foocoin"), 10)})
 
-	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{receiveMsg}, []uint64{0}, []uint64{2}, false, false, priv1)
+	blockHeader = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, blockHeader, []sdk.Msg{receiveMsg}, []uint64{0}, []uint64{3}, false, false, priv1)
 }
@@ -19,6 +19,8 @@ const (
 	flagTo     = "to"
 	flagAmount = "amount"
 	flagChain  = "chain"
+
+	flagTimeoutHeight = "timeout-height"
 )
 
 // IBCTransferCmd implements the IBC transfer command.
@@ -44,6 +46,7 @@ func IBCTransferCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagTo, "", "Address to send coins")
 	cmd.Flags().String(flagAmount, "", "Amount of coins to send")
 	cmd.Flags().String(flagChain, "", "Destination chain to send coins")
+	cmd.Flags().Uint64(flagTimeoutHeight, 0, "Height of the destination chain from which the coins are refunded if not received")
 
 	return cmd
 }
@@ -63,7 +66,7 @@ func buildMsg(from sdk.AccAddress) (sdk.Msg, error) {
 	to := sdk.AccAddress(bz)
 
 	packet := ibc.NewIBCPacket(from, to, coins, viper.GetString(client.FlagChainID),
-		viper.GetString(flagChain))
+		viper.GetString(flagChain), uint64(viper.GetInt64(flagTimeoutHeight)))
 
 	msg := ibc.MsgIBCTransfer{
 		IBCPacket: packet,
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	ibcutils "github.com/cosmos/cosmos-sdk/x/ibc/client/utils"
+)
+
+const (
+	flagFromChainHeight = "from-chain-height"
+	flagTitle           = "title"
+	flagDescription     = "description"
+	flagDeposit         = "deposit"
+)
+
+// GetCmdSubmitCreateClientProposal implements a command handler for submitting
+// a proposal creating the light client of a counterparty chain.
+func GetCmdSubmitCreateClientProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-client",
+		Short: "Submit a proposal creating the light client of a counterparty chain",
+		Long: strings.TrimSpace(`
+Submit a proposal creating the light client of a counterparty chain along with
+an initial deposit. The client trusts the header of the counterparty chain,
+the latest one by default, when the proposal passes; voters should check that
+its validator set is the one of that chain:
+
+$ gaiacli tx gov submit-proposal create-client --from-chain-node=tcp://localhost:36657 --title="IBC" --description="Track chain-b" --deposit="10test" --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			header, err := queryClientHeader(cmd)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := ibc.NewCreateClientProposal(viper.GetString(flagTitle), viper.GetString(flagDescription), header)
+			msg := gov.NewMsgSubmitProposal(content, from, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "title of proposal")
+	cmd.Flags().String(flagDescription, "", "description of proposal")
+	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+
+	return clientHeaderFlags(cmd)
+}
+
+// IBCUpdateClientCmd implements the command updating the light client of a
+// counterparty chain.
+func IBCUpdateClientCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-client",
+		Short: "Update the light client of a counterparty chain with a later header",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return sendClientHeader(cdc, cmd, func(header ibc.Header, from sdk.AccAddress) sdk.Msg {
+				return ibc.NewMsgUpdateClient(header, from)
+			})
+		},
+	}
+
+	return clientHeaderFlags(cmd)
+}
+
+func clientHeaderFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(FlagFromChainNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for the counterparty chain")
+	cmd.Flags().Int64(flagFromChainHeight, 0, "Height of the header of the counterparty chain, the latest one by default")
+	return cmd
+}
+
+// sendClientHeader builds the client message with the header of the
+// counterparty chain and broadcasts it.
+func sendClientHeader(cdc *codec.Codec, cmd *cobra.Command, newMsg func(ibc.Header, sdk.AccAddress) sdk.Msg) error {
+	txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(cdc)
+
+	header, err := queryClientHeader(cmd)
+	if err != nil {
+		return err
+	}
+
+	msg := newMsg(header, cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+}
+
+// queryClientHeader queries the header of the counterparty chain given by the
+// flags.
+func queryClientHeader(cmd *cobra.Command) (ibc.Header, error) {
+	node, _ := cmd.Flags().GetString(FlagFromChainNode)
+	height, _ := cmd.Flags().GetInt64(flagFromChainHeight)
+	return ibcutils.QueryHeader(node, height)
+}
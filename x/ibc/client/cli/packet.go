@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	ibcutils "github.com/cosmos/cosmos-sdk/x/ibc/client/utils"
+)
+
+// IBCAcknowledgeCmd implements the command proving that the destination chain
+// of an outgoing packet acknowledged it.
+func IBCAcknowledgeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acknowledge [dest-chain] [sequence]",
+		Short: "Prove that the destination chain of an outgoing packet acknowledged it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return sendPacketProof(cdc, cmd, args, func(destChain string, sequence uint64,
+				proof *merkle.Proof, header ibc.Header, from sdk.AccAddress) sdk.Msg {
+				return ibc.NewMsgIBCAcknowledgement(destChain, sequence, proof, header, from)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagToChainNode, "tcp://localhost:36657", "<host>:<port> to tendermint rpc interface for the destination chain")
+	return cmd
+}
+
+// IBCTimeoutCmd implements the command refunding an outgoing packet the
+// destination chain did not receive before its timeout height.
+func IBCTimeoutCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeout [dest-chain] [sequence]",
+		Short: "Refund an outgoing packet the destination chain did not receive before its timeout height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return sendPacketProof(cdc, cmd, args, func(destChain string, sequence uint64,
+				proof *merkle.Proof, header ibc.Header, from sdk.AccAddress) sdk.Msg {
+				return ibc.NewMsgIBCTimeout(destChain, sequence, proof, header, from)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagToChainNode, "tcp://localhost:36657", "<host>:<port> to tendermint rpc interface for the destination chain")
+	return cmd
+}
+
+// sendPacketProof builds the message proving the acknowledgement of a packet,
+// or its absence, on the destination chain and broadcasts it. The proof is
+// queried at the height below the latest header of the destination chain,
+// whose app hash commits the proven state.
+func sendPacketProof(cdc *codec.Codec, cmd *cobra.Command, args []string,
+	newMsg func(string, uint64, *merkle.Proof, ibc.Header, sdk.AccAddress) sdk.Msg) error {
+
+	txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(cdc)
+
+	destChain := args[0]
+	sequence, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("sequence %s not a valid uint, please input a valid sequence", args[1])
+	}
+
+	node, _ := cmd.Flags().GetString(FlagToChainNode)
+	header, err := ibcutils.QueryHeader(node, 0)
+	if err != nil {
+		return err
+	}
+
+	ackKey := ibc.AckKey(viper.GetString(client.FlagChainID), sequence)
+	res, err := ibcutils.QueryWithProof(node, ackKey, ibc.StoreKey, header.Height()-1)
+	if err != nil {
+		return err
+	}
+
+	msg := newMsg(destChain, sequence, res.Proof, header, cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+}
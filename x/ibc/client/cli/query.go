@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	ibcutils "github.com/cosmos/cosmos-sdk/x/ibc/client/utils"
+)
+
+// GetCmdQueryPacket implements the command to query an outgoing IBC packet
+// and its status.
+func GetCmdQueryPacket(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "packet [dest-chain] [sequence]",
+		Short: "Query an outgoing IBC packet and its status",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(`Query an outgoing IBC packet by its destination chain and sequence. Its
+status is Pending until the packet is acknowledged by the destination chain or
+timed out and refunded:
+
+$ gaiacli query ibc packet other-chain 0
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("sequence %s not a valid uint, please input a valid sequence", args[1])
+			}
+
+			info, err := ibcutils.QueryPacketInfo(cliCtx, storeName, args[0], sequence)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
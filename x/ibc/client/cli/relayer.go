@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/client/relayer"
+)
+
+// flags
+const (
+	FlagFromChainNode = "from-chain-node"
+	FlagToChainNode   = "to-chain-node"
+
+	flagCheckpoints    = "checkpoints"
+	flagBatchSize      = "batch-size"
+	flagPollInterval   = "poll-interval"
+	flagMaxBackoff     = "max-backoff"
+	flagPrometheus     = "prometheus"
+	flagPrometheusAddr = "prometheus-listen-addr"
+)
+
+// IBCRelayerCmd implements the command running a relayer between two chains.
+func IBCRelayerCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer",
+		Short: "Relay IBC packets between two chains",
+		Long: `Relay the IBC packets sent between two chains, along with the acknowledgements
+and timeouts of the packets back to their source chain, until interrupted. The
+light client of each chain must already track the other chain, created by a
+create-client governance proposal.
+
+The relayer batches the messages to each chain into transactions signed with the
+--from key, retries with exponential backoff after a failure and stores its
+progress in the checkpoints file to resume where it stopped.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// the responses of each chain are proven to the other chain
+			viper.Set(client.FlagTrustNode, true)
+
+			name := context.NewCLIContext().GetFromName()
+			passphrase, err := keys.GetPassphrase(name)
+			if err != nil {
+				return err
+			}
+
+			a, err := relayer.NewRPCChain(cdc, viper.GetString(FlagFromChainNode), name, passphrase)
+			if err != nil {
+				return err
+			}
+			b, err := relayer.NewRPCChain(cdc, viper.GetString(FlagToChainNode), name, passphrase)
+			if err != nil {
+				return err
+			}
+
+			file := viper.GetString(flagCheckpoints)
+			if file == "" {
+				file = filepath.Join(viper.GetString(cli.HomeFlag), "relayer", "checkpoints.json")
+			}
+			checkpoints, err := relayer.LoadCheckpointStore(file)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "ibc-relayer")
+
+			metrics := relayer.NopMetrics()
+			if viper.GetBool(flagPrometheus) {
+				// bind the listener first so that a taken address fails the command
+				listener, err := net.Listen("tcp", viper.GetString(flagPrometheusAddr))
+				if err != nil {
+					return fmt.Errorf("failed to listen for prometheus metrics: %v", err)
+				}
+
+				metrics = relayer.PrometheusMetrics("gaiacli")
+				go func() {
+					if err := http.Serve(listener, promhttp.Handler()); err != nil {
+						logger.Error("prometheus metrics server stopped", "err", err)
+					}
+				}()
+			}
+
+			config := relayer.DefaultConfig()
+			config.BatchSize = viper.GetInt(flagBatchSize)
+			config.PollInterval = viper.GetDuration(flagPollInterval)
+			config.MaxBackoff = viper.GetDuration(flagMaxBackoff)
+
+			r := relayer.NewRelayer(cdc, a, b, checkpoints, config, metrics, logger)
+
+			done := make(chan struct{})
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				close(done)
+			}()
+
+			r.Run(done)
+			return nil
+		},
+	}
+
+	config := relayer.DefaultConfig()
+	cmd.Flags().String(client.FlagFrom, "", "Name of private key with which to sign on both chains")
+	cmd.Flags().String(client.FlagFees, "", "Fees to pay along with each transaction; eg: 10stake,1atom")
+	cmd.Flags().String(client.FlagGasPrices, "", "Gas prices to determine the transaction fee (e.g. 0.00001stake)")
+	cmd.Flags().Float64(client.FlagGasAdjustment, client.DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
+	cmd.Flags().Var(&client.GasFlagVar, "gas", fmt.Sprintf(
+		"gas limit to set per-transaction; set to %q to calculate required gas automatically (default %d)",
+		client.GasFlagAuto, client.DefaultGasLimit,
+	))
+	cmd.Flags().String(FlagFromChainNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for the first chain")
+	cmd.Flags().String(FlagToChainNode, "tcp://localhost:36657", "<host>:<port> to tendermint rpc interface for the second chain")
+	cmd.Flags().String(flagCheckpoints, "", "File storing the progress of the relayer, <home>/relayer/checkpoints.json by default")
+	cmd.Flags().Int(flagBatchSize, config.BatchSize, "Maximum number of messages in a transaction")
+	cmd.Flags().Duration(flagPollInterval, config.PollInterval, "Interval between two relay attempts")
+	cmd.Flags().Duration(flagMaxBackoff, config.MaxBackoff, "Maximum delay before retrying after a failure")
+	cmd.Flags().Bool(flagPrometheus, false, "Expose the metrics of the relayer to Prometheus")
+	cmd.Flags().String(flagPrometheusAddr, ":26661", "Address to listen on for Prometheus collector(s) connections")
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/ibc/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	// Group ibc queries under a subcommand
+	ibcQueryCmd := &cobra.Command{
+		Use:   ibc.ModuleName,
+		Short: "Querying commands for the IBC module",
+	}
+
+	ibcQueryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryPacket(mc.storeKey, mc.cdc),
+	)...)
+
+	return ibcQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	ibcTxCmd := &cobra.Command{
+		Use:   ibc.ModuleName,
+		Short: "IBC transaction subcommands",
+	}
+
+	ibcTxCmd.AddCommand(client.PostCommands(
+		cli.IBCTransferCmd(mc.cdc),
+		cli.IBCUpdateClientCmd(mc.cdc),
+		cli.IBCAcknowledgeCmd(mc.cdc),
+		cli.IBCTimeoutCmd(mc.cdc),
+	)...)
+
+	return ibcTxCmd
+}
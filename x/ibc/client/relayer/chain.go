@@ -0,0 +1,141 @@
+package relayer
+
+import (
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	ibcutils "github.com/cosmos/cosmos-sdk/x/ibc/client/utils"
+)
+
+// Chain is a chain the relayer relays IBC packets from and to.
+type Chain interface {
+	// ChainID returns the ID of the chain.
+	ChainID() string
+
+	// Address returns the address of the relayer on the chain, which signs
+	// the transactions broadcasted to it.
+	Address() sdk.AccAddress
+
+	// LatestHeader returns the latest header of the chain with its validator
+	// sets. Its app hash commits the state of the height below it.
+	LatestHeader() (ibc.Header, error)
+
+	// QueryIBC returns the value of a key of the IBC store at the given
+	// height, the latest one if zero, with the proof of its value or absence.
+	QueryIBC(key []byte, height int64) ([]byte, *merkle.Proof, error)
+
+	// Broadcast signs a transaction with the messages, broadcasts it and
+	// waits for it to be committed.
+	Broadcast(msgs []sdk.Msg) error
+}
+
+// rpcChain is a chain reached through the RPC interface of one of its nodes.
+// It keeps track of the sequence of the relayer account to broadcast a
+// transaction in each block, and reloads it from the chain after a failure.
+type rpcChain struct {
+	chainID    string
+	node       string
+	name       string
+	passphrase string
+
+	cliCtx context.CLIContext
+	txBldr authtxb.TxBuilder
+	synced bool
+}
+
+var _ Chain = (*rpcChain)(nil)
+
+// NewRPCChain returns the chain behind the node, on which the transactions are
+// signed with the key of the given name.
+func NewRPCChain(cdc *codec.Codec, node, name, passphrase string) (Chain, error) {
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(cdc).
+		WithNodeURI(node).
+		WithTrustNode(true).
+		WithVerifier(nil).
+		WithFromName(name)
+
+	rpc, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	status, err := rpc.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := status.NodeInfo.Network
+	txBldr := authtxb.NewTxBuilderFromCLI().
+		WithTxEncoder(utils.GetTxEncoder(cdc)).
+		WithChainID(chainID)
+
+	info, err := txBldr.Keybase().Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcChain{
+		chainID:    chainID,
+		node:       node,
+		name:       name,
+		passphrase: passphrase,
+		cliCtx:     cliCtx.WithFromAddress(info.GetAddress()),
+		txBldr:     txBldr,
+	}, nil
+}
+
+// nolint
+func (c *rpcChain) ChainID() string         { return c.chainID }
+func (c *rpcChain) Address() sdk.AccAddress { return c.cliCtx.GetFromAddress() }
+
+func (c *rpcChain) LatestHeader() (ibc.Header, error) {
+	return ibcutils.QueryHeader(c.node, 0)
+}
+
+func (c *rpcChain) QueryIBC(key []byte, height int64) ([]byte, *merkle.Proof, error) {
+	res, err := ibcutils.QueryWithProof(c.node, key, ibc.StoreKey, height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.Value, res.Proof, nil
+}
+
+func (c *rpcChain) Broadcast(msgs []sdk.Msg) error {
+	if !c.synced {
+		txBldr, err := utils.PrepareTxBuilder(c.txBldr.WithAccountNumber(0).WithSequence(0), c.cliCtx)
+		if err != nil {
+			return err
+		}
+		c.txBldr, c.synced = txBldr, true
+	}
+
+	txBldr := c.txBldr
+	if txBldr.SimulateAndExecute() {
+		var err error
+		if txBldr, err = utils.EnrichWithGas(txBldr, c.cliCtx, msgs); err != nil {
+			c.synced = false
+			return err
+		}
+	}
+
+	txBytes, err := txBldr.BuildAndSign(c.name, c.passphrase, msgs)
+	if err != nil {
+		return err
+	}
+
+	// a transaction failing after its signature was checked still increments
+	// the sequence, so the sequence is reloaded after any failure
+	if _, err := c.cliCtx.BroadcastTxAndAwaitCommit(txBytes); err != nil {
+		c.synced = false
+		return err
+	}
+
+	c.txBldr = c.txBldr.WithSequence(c.txBldr.Sequence() + 1)
+	return nil
+}
@@ -0,0 +1,72 @@
+package relayer
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Checkpoint is the progress of the relayer on the packets sent from a chain
+// to another. The packets to relay are the ones above the ingress sequence of
+// the destination chain, so only the settlement of the packets on their source
+// chain needs to be tracked.
+type Checkpoint struct {
+	// Settled is the sequence of the first packet of the source chain neither
+	// acknowledged nor timed out. The packets below it are not scanned again.
+	Settled uint64 `json:"settled"`
+}
+
+// CheckpointStore keeps the checkpoints of the relayer in a JSON file, keyed by
+// source and destination chain, so that it resumes where it stopped.
+type CheckpointStore struct {
+	file        string
+	checkpoints map[string]map[string]Checkpoint
+}
+
+// LoadCheckpointStore loads the checkpoints stored in the file, if it exists.
+func LoadCheckpointStore(file string) (*CheckpointStore, error) {
+	store := &CheckpointStore{
+		file:        file,
+		checkpoints: make(map[string]map[string]Checkpoint),
+	}
+
+	bz, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(bz, &store.checkpoints); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// Get returns the checkpoint of the packets sent from a chain to another.
+func (s *CheckpointStore) Get(srcChain, destChain string) Checkpoint {
+	return s.checkpoints[srcChain][destChain]
+}
+
+// Set stores the checkpoint of the packets sent from a chain to another and
+// writes the checkpoints to the file.
+func (s *CheckpointStore) Set(srcChain, destChain string, cp Checkpoint) error {
+	if s.checkpoints[srcChain] == nil {
+		s.checkpoints[srcChain] = make(map[string]Checkpoint)
+	}
+	s.checkpoints[srcChain][destChain] = cp
+
+	bz, err := json.MarshalIndent(s.checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := cmn.EnsureDir(filepath.Dir(s.file), 0700); err != nil {
+		return err
+	}
+	return cmn.WriteFileAtomic(s.file, bz, 0600)
+}
@@ -0,0 +1,92 @@
+package relayer
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "ibc_relayer"
+)
+
+// Metrics contains metrics exposed by this package. Every metric is labelled
+// with the source and destination chains of the relayed packets.
+type Metrics struct {
+	// Number of packets sent and not yet received by the destination chain.
+	PendingPackets metrics.Gauge
+	// Number of packets neither acknowledged nor timed out on the source chain.
+	UnsettledPackets metrics.Gauge
+	// Number of packets relayed to the destination chain.
+	RelayedPackets metrics.Counter
+	// Number of acknowledgements relayed to the source chain.
+	Acknowledgements metrics.Counter
+	// Number of timeouts relayed to the source chain.
+	Timeouts metrics.Counter
+	// Number of failed relay attempts.
+	Failures metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	labels = append(labels, "src_chain", "dest_chain")
+	return &Metrics{
+		PendingPackets: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pending_packets",
+			Help:      "Number of packets sent and not yet received by the destination chain.",
+		}, labels).With(labelsAndValues...),
+		UnsettledPackets: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "unsettled_packets",
+			Help:      "Number of packets neither acknowledged nor timed out on the source chain.",
+		}, labels).With(labelsAndValues...),
+		RelayedPackets: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "relayed_packets",
+			Help:      "Number of packets relayed to the destination chain.",
+		}, labels).With(labelsAndValues...),
+		Acknowledgements: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "acknowledgements",
+			Help:      "Number of acknowledgements relayed to the source chain.",
+		}, labels).With(labelsAndValues...),
+		Timeouts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "timeouts",
+			Help:      "Number of timeouts relayed to the source chain.",
+		}, labels).With(labelsAndValues...),
+		Failures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "failures",
+			Help:      "Number of failed relay attempts.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		PendingPackets:   discard.NewGauge(),
+		UnsettledPackets: discard.NewGauge(),
+		RelayedPackets:   discard.NewCounter(),
+		Acknowledgements: discard.NewCounter(),
+		Timeouts:         discard.NewCounter(),
+		Failures:         discard.NewCounter(),
+	}
+}
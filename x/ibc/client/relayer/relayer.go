@@ -0,0 +1,298 @@
+package relayer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+// Config is the configuration of a relayer.
+type Config struct {
+	// BatchSize is the maximum number of messages in a transaction.
+	BatchSize int
+	// PollInterval is the interval between two relay attempts.
+	PollInterval time.Duration
+	// MinBackoff is the delay before retrying after a failure, doubled after
+	// each consecutive failure.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay before retrying after a failure.
+	MaxBackoff time.Duration
+}
+
+// DefaultConfig returns the default configuration of a relayer.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize:    20,
+		PollInterval: time.Second,
+		MinBackoff:   time.Second,
+		MaxBackoff:   time.Minute,
+	}
+}
+
+// backoff returns the delay before retrying after the given number of
+// consecutive failures.
+func (c Config) backoff(failures int) time.Duration {
+	backoff := c.MinBackoff
+	for i := 1; i < failures && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.MaxBackoff {
+		return c.MaxBackoff
+	}
+	return backoff
+}
+
+// path is a direction in which the relayer relays packets, from the chain
+// sending them to the chain receiving them.
+type path struct {
+	src, dest Chain
+	failures  int
+	retryAt   time.Time
+}
+
+// Relayer relays the IBC packets sent between two chains and the
+// acknowledgements and timeouts of the packets back to their source chain.
+// The light clients of each chain must already track the other chain.
+type Relayer struct {
+	cdc         *codec.Codec
+	paths       []*path
+	checkpoints *CheckpointStore
+	config      Config
+	metrics     *Metrics
+	logger      log.Logger
+}
+
+// NewRelayer returns a relayer between the two chains.
+func NewRelayer(cdc *codec.Codec, a, b Chain, checkpoints *CheckpointStore,
+	config Config, metrics *Metrics, logger log.Logger) *Relayer {
+
+	return &Relayer{
+		cdc:         cdc,
+		paths:       []*path{{src: a, dest: b}, {src: b, dest: a}},
+		checkpoints: checkpoints,
+		config:      config,
+		metrics:     metrics,
+		logger:      logger,
+	}
+}
+
+// Run relays packets in both directions every poll interval until done is
+// closed. After a failure, a direction is retried with exponential backoff.
+func (r *Relayer) Run(done <-chan struct{}) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+		for _, p := range r.paths {
+			if now.Before(p.retryAt) {
+				continue
+			}
+
+			if err := r.relay(p); err != nil {
+				p.failures++
+				backoff := r.config.backoff(p.failures)
+				p.retryAt = now.Add(backoff)
+				r.logger.Error("failed to relay packets", "src", p.src.ChainID(),
+					"dest", p.dest.ChainID(), "err", err, "retry", backoff)
+				continue
+			}
+			p.failures, p.retryAt = 0, time.Time{}
+		}
+
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay relays a batch of packets and a batch of acknowledgements and
+// timeouts in each direction once.
+func (r *Relayer) Relay() error {
+	for _, p := range r.paths {
+		if err := r.relay(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// relay relays packets from the source chain of the path to its destination
+// chain, then acknowledgements and timeouts of packets of the source chain back
+// to it. The packets are proven at the height below the latest header of the
+// source chain, whose app hash commits their state, and the acknowledgements
+// and their absence likewise at the height below the latest header of the
+// destination chain.
+func (r *Relayer) relay(p *path) (err error) {
+	defer func() {
+		if err != nil {
+			r.metrics.Failures.With(r.labels(p)...).Add(1)
+		}
+	}()
+
+	srcHeader, err := p.src.LatestHeader()
+	if err != nil {
+		return err
+	}
+	destHeader, err := p.dest.LatestHeader()
+	if err != nil {
+		return err
+	}
+
+	// the first header of a chain commits no state
+	if srcHeader.Height() < 2 || destHeader.Height() < 2 {
+		return nil
+	}
+
+	var length uint64
+	if err := r.query(p.src, ibc.EgressLengthKey(p.dest.ChainID()), srcHeader.Height()-1, &length); err != nil {
+		return err
+	}
+
+	if err := r.receive(p, srcHeader, destHeader, length); err != nil {
+		return err
+	}
+	return r.settle(p, srcHeader, destHeader, length)
+}
+
+// receive relays the packets above the ingress sequence of the destination
+// chain, as of its latest header, to it.
+func (r *Relayer) receive(p *path, header, destHeader ibc.Header, length uint64) error {
+	var ingress uint64
+	if err := r.query(p.dest, ibc.IngressSequenceKey(p.src.ChainID()), destHeader.Height(), &ingress); err != nil {
+		return err
+	}
+	if ingress > length {
+		ingress = length
+	}
+	r.metrics.PendingPackets.With(r.labels(p)...).Set(float64(length - ingress))
+
+	var msgs []sdk.Msg
+	for seq := ingress; seq < length && len(msgs) < r.config.BatchSize; seq++ {
+		bz, proof, err := p.src.QueryIBC(ibc.EgressKey(p.dest.ChainID(), seq), header.Height()-1)
+		if err != nil {
+			return err
+		}
+
+		var packet ibc.IBCPacket
+		if err := r.cdc.UnmarshalBinaryLengthPrefixed(bz, &packet); err != nil {
+			return err
+		}
+
+		msgs = append(msgs, ibc.MsgIBCReceive{
+			IBCPacket: packet,
+			Proof:     proof,
+			Header:    header,
+			Relayer:   p.dest.Address(),
+			Sequence:  seq,
+		})
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := p.dest.Broadcast(msgs); err != nil {
+		return err
+	}
+
+	r.metrics.RelayedPackets.With(r.labels(p)...).Add(float64(len(msgs)))
+	r.logger.Info("relayed packets", "src", p.src.ChainID(), "dest", p.dest.ChainID(),
+		"from", ingress, "count", len(msgs))
+	return nil
+}
+
+// settle relays the acknowledgements of the pending packets of the source
+// chain, and the timeouts of the ones the destination chain can no longer
+// receive, back to the source chain. The checkpoint of the path moves past the
+// packets already settled as of the latest header of the source chain.
+func (r *Relayer) settle(p *path, srcHeader, header ibc.Header, length uint64) error {
+	cp := r.checkpoints.Get(p.src.ChainID(), p.dest.ChainID())
+	settled := cp.Settled
+
+	var msgs []sdk.Msg
+	var acks, timeouts int
+	for seq := cp.Settled; seq < length && len(msgs) < r.config.BatchSize; seq++ {
+		status, _, err := p.src.QueryIBC(ibc.PacketStatusKey(p.dest.ChainID(), seq), srcHeader.Height())
+		if err != nil {
+			return err
+		}
+		if len(status) == 0 || ibc.PacketStatus(status[0]) != ibc.StatusPending {
+			if settled == seq {
+				settled++
+			}
+			continue
+		}
+
+		ack, proof, err := p.dest.QueryIBC(ibc.AckKey(p.src.ChainID(), seq), header.Height()-1)
+		if err != nil {
+			return err
+		}
+		if ack != nil {
+			msgs = append(msgs, ibc.NewMsgIBCAcknowledgement(p.dest.ChainID(), seq, proof, header, p.src.Address()))
+			acks++
+			continue
+		}
+
+		bz, _, err := p.src.QueryIBC(ibc.EgressKey(p.dest.ChainID(), seq), srcHeader.Height())
+		if err != nil {
+			return err
+		}
+
+		var packet ibc.IBCPacket
+		if err := r.cdc.UnmarshalBinaryLengthPrefixed(bz, &packet); err != nil {
+			return err
+		}
+		if packet.TimedOut(header.Height()) {
+			msgs = append(msgs, ibc.NewMsgIBCTimeout(p.dest.ChainID(), seq, proof, header, p.src.Address()))
+			timeouts++
+		}
+	}
+
+	r.metrics.UnsettledPackets.With(r.labels(p)...).Set(float64(length - settled))
+	if settled != cp.Settled {
+		cp.Settled = settled
+		if err := r.checkpoints.Set(p.src.ChainID(), p.dest.ChainID(), cp); err != nil {
+			return err
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := p.src.Broadcast(msgs); err != nil {
+		return err
+	}
+
+	r.metrics.Acknowledgements.With(r.labels(p)...).Add(float64(acks))
+	r.metrics.Timeouts.With(r.labels(p)...).Add(float64(timeouts))
+	r.logger.Info("settled packets", "src", p.src.ChainID(), "dest", p.dest.ChainID(),
+		"acknowledged", acks, "timed_out", timeouts)
+	return nil
+}
+
+// query decodes the value of a key of the IBC store of a chain at the given
+// height, leaving the pointer untouched if the key is not set.
+func (r *Relayer) query(chain Chain, key []byte, height int64, ptr interface{}) error {
+	bz, _, err := chain.QueryIBC(key, height)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return nil
+	}
+	if err := r.cdc.UnmarshalBinaryLengthPrefixed(bz, ptr); err != nil {
+		return fmt.Errorf("failed to decode %s of chain %s: %v", key, chain.ChainID(), err)
+	}
+	return nil
+}
+
+func (r *Relayer) labels(p *path) []string {
+	return []string{"src_chain", p.src.ChainID(), "dest_chain", p.dest.ChainID()}
+}
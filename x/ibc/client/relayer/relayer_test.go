@@ -0,0 +1,387 @@
+package relayer
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/lite"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+)
+
+// testChain is an in-process chain running the IBC module, whose headers are
+// signed by a fixed validator set.
+type testChain struct {
+	t         *testing.T
+	chainID   string
+	app       *mock.App
+	ibcMapper ibc.Mapper
+	vals      *tmtypes.ValidatorSet
+	sign      func(height int64, appHash []byte) tmtypes.SignedHeader
+	appHashes map[int64][]byte
+
+	relayer crypto.PrivKey
+	user    crypto.PrivKey
+}
+
+var _ Chain = (*testChain)(nil)
+
+func newTestChain(t *testing.T, chainID string) *testChain {
+	mapp := mock.NewApp()
+
+	keyIBC := sdk.NewKVStoreKey(ibc.StoreKey)
+	ibcMapper := ibc.NewMapper(mapp.Cdc, keyIBC, ibc.DefaultCodespace)
+	bankKeeper := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.SupplyKeeper,
+		mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace,
+		map[string][]string{ibc.ModuleName: {auth.Minter, auth.Burner}})
+	mapp.AddModules(ibc.NewAppModule(ibcMapper, bankKeeper))
+
+	// keep the past states, which the relayer proves packets at
+	bam.SetPruning(store.PruneNothing)(mapp.BaseApp)
+	require.NoError(t, mapp.CompleteSetup(keyIBC))
+
+	keys := lite.GenSecpPrivKeys(4)
+	vals := keys.ToValidators(1, 0)
+	c := &testChain{
+		t:         t,
+		chainID:   chainID,
+		app:       mapp,
+		ibcMapper: ibcMapper,
+		vals:      vals,
+		sign: func(height int64, appHash []byte) tmtypes.SignedHeader {
+			return keys.GenSignedHeader(chainID, height, nil, vals, vals, appHash, nil, nil, 0, len(keys))
+		},
+		appHashes: make(map[int64][]byte),
+		relayer:   secp256k1.GenPrivKey(),
+		user:      secp256k1.GenPrivKey(),
+	}
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	mapp.GenesisAccounts = []auth.Account{
+		&auth.BaseAccount{Address: c.Address(), Coins: coins},
+		&auth.BaseAccount{Address: c.userAddress(), Coins: coins},
+	}
+	mapp.InitChain(abci.RequestInitChain{ChainId: chainID})
+	c.commit()
+
+	return c
+}
+
+func (c *testChain) ChainID() string         { return c.chainID }
+func (c *testChain) Address() sdk.AccAddress { return sdk.AccAddress(c.relayer.PubKey().Address()) }
+
+func (c *testChain) LatestHeader() (ibc.Header, error) {
+	return c.header(c.app.LastBlockHeight()), nil
+}
+
+func (c *testChain) QueryIBC(key []byte, height int64) ([]byte, *merkle.Proof, error) {
+	res := c.app.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", ibc.StoreKey),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if !res.IsOK() {
+		return nil, nil, errors.New(res.Log)
+	}
+	return res.Value, res.Proof, nil
+}
+
+func (c *testChain) Broadcast(msgs []sdk.Msg) error {
+	res := c.deliver(msgs, c.relayer)
+	if !res.IsOK() {
+		return errors.New(res.Log)
+	}
+	return nil
+}
+
+// header returns the header of the given height, committing the app hash of
+// the height below it.
+func (c *testChain) header(height int64) ibc.Header {
+	return ibc.NewHeader(c.sign(height, c.appHashes[height-1]), c.vals, c.vals)
+}
+
+// deliver commits a block with a transaction of the messages signed by the key.
+func (c *testChain) deliver(msgs []sdk.Msg, priv crypto.PrivKey) sdk.Result {
+	ctx := c.app.BaseApp.NewContext(true, abci.Header{})
+	acc := c.app.AccountKeeper.GetAccount(ctx, sdk.AccAddress(priv.PubKey().Address()))
+
+	fee := auth.NewStdFee(10000000, sdk.NewCoins())
+	sig, err := priv.Sign(auth.StdSignBytes(c.chainID, acc.GetAccountNumber(), acc.GetSequence(), fee, msgs, ""))
+	require.NoError(c.t, err)
+	tx := auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, "")
+
+	header := abci.Header{ChainID: c.chainID, Height: c.app.LastBlockHeight() + 1}
+	c.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := c.app.Deliver(tx)
+	c.app.EndBlock(abci.RequestEndBlock{})
+	c.commit()
+	return res
+}
+
+// createClient commits a block creating the light client of a chain, as a
+// passed create client proposal would.
+func (c *testChain) createClient(header ibc.Header) {
+	blockHeader := abci.Header{ChainID: c.chainID, Height: c.app.LastBlockHeight() + 1}
+	c.app.BeginBlock(abci.RequestBeginBlock{Header: blockHeader})
+	err := c.ibcMapper.CreateClient(c.app.BaseApp.NewContext(false, blockHeader), header)
+	require.Nil(c.t, err)
+	c.app.EndBlock(abci.RequestEndBlock{})
+	c.commit()
+}
+
+// nextBlocks commits empty blocks.
+func (c *testChain) nextBlocks(n int) {
+	for i := 0; i < n; i++ {
+		header := abci.Header{ChainID: c.chainID, Height: c.app.LastBlockHeight() + 1}
+		c.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		c.app.EndBlock(abci.RequestEndBlock{})
+		c.commit()
+	}
+}
+
+func (c *testChain) commit() {
+	c.app.Commit()
+	c.appHashes[c.app.LastBlockHeight()] = c.app.LastCommitID().Hash
+}
+
+func (c *testChain) userAddress() sdk.AccAddress {
+	return sdk.AccAddress(c.user.PubKey().Address())
+}
+
+// transfer sends a coin of the user to the user of the destination chain.
+func (c *testChain) transfer(dest *testChain, coin sdk.Coin, timeoutHeight uint64) {
+	packet := ibc.NewIBCPacket(c.userAddress(), dest.userAddress(), sdk.NewCoins(coin),
+		c.chainID, dest.chainID, timeoutHeight)
+	res := c.deliver([]sdk.Msg{ibc.MsgIBCTransfer{IBCPacket: packet}}, c.user)
+	require.True(c.t, res.IsOK(), res.Log)
+}
+
+func (c *testChain) coins(addr sdk.AccAddress) sdk.Coins {
+	ctx := c.app.BaseApp.NewContext(true, abci.Header{})
+	return c.app.AccountKeeper.GetAccount(ctx, addr).GetCoins()
+}
+
+func (c *testChain) ingressSequence(src *testChain) (seq uint64) {
+	bz, _, err := c.QueryIBC(ibc.IngressSequenceKey(src.chainID), c.app.LastBlockHeight())
+	require.NoError(c.t, err)
+	if bz != nil {
+		c.app.Cdc.MustUnmarshalBinaryLengthPrefixed(bz, &seq)
+	}
+	return seq
+}
+
+func (c *testChain) packetStatus(dest *testChain, seq uint64) ibc.PacketStatus {
+	bz, _, err := c.QueryIBC(ibc.PacketStatusKey(dest.chainID, seq), c.app.LastBlockHeight())
+	require.NoError(c.t, err)
+	require.Len(c.t, bz, 1)
+	return ibc.PacketStatus(bz[0])
+}
+
+// setupChains returns two chains whose light clients track each other and the
+// file storing the checkpoints of a relayer between them.
+func setupChains(t *testing.T) (a, b *testChain, file string, cleanup func()) {
+	a, b = newTestChain(t, "chain-a"), newTestChain(t, "chain-b")
+	a.nextBlocks(1)
+	b.nextBlocks(1)
+
+	hb, _ := b.LatestHeader()
+	a.createClient(hb)
+	ha, _ := a.LatestHeader()
+	b.createClient(ha)
+
+	dir, err := ioutil.TempDir("", "relayer")
+	require.NoError(t, err)
+	return a, b, filepath.Join(dir, "checkpoints.json"), func() { os.RemoveAll(dir) }
+}
+
+func newTestRelayer(t *testing.T, a, b *testChain, file string, config Config) *Relayer {
+	checkpoints, err := LoadCheckpointStore(file)
+	require.NoError(t, err)
+	return NewRelayer(a.app.Cdc, a, b, checkpoints, config, NopMetrics(), log.NewNopLogger())
+}
+
+// step commits a block on both chains, so that their latest headers commit the
+// previous transactions, and relays once.
+func step(t *testing.T, r *Relayer, a, b *testChain) {
+	a.nextBlocks(1)
+	b.nextBlocks(1)
+	require.NoError(t, r.Relay())
+}
+
+func TestRelayer(t *testing.T) {
+	a, b, file, cleanup := setupChains(t)
+	defer cleanup()
+
+	r := newTestRelayer(t, a, b, file, DefaultConfig())
+
+	a.transfer(b, sdk.NewInt64Coin("stake", 10), 1000)
+	b.transfer(a, sdk.NewInt64Coin("stake", 20), 1000)
+	step(t, r, a, b)
+
+	// the packets are received in both directions
+	require.Equal(t, uint64(1), b.ingressSequence(a))
+	require.Equal(t, uint64(1), a.ingressSequence(b))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 80), sdk.NewInt64Coin(ibc.VoucherDenom("chain-a", "stake"), 10)),
+		b.coins(b.userAddress()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90), sdk.NewInt64Coin(ibc.VoucherDenom("chain-b", "stake"), 20)),
+		a.coins(a.userAddress()))
+
+	// the acknowledgements are relayed back
+	step(t, r, a, b)
+	require.Equal(t, ibc.StatusAcknowledged, a.packetStatus(b, 0))
+	require.Equal(t, ibc.StatusAcknowledged, b.packetStatus(a, 0))
+
+	// the checkpoints move past the settled packets
+	step(t, r, a, b)
+	checkpoints, err := LoadCheckpointStore(file)
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{Settled: 1}, checkpoints.Get("chain-a", "chain-b"))
+	require.Equal(t, Checkpoint{Settled: 1}, checkpoints.Get("chain-b", "chain-a"))
+
+	// vouchers sent back are exchanged for the escrowed coins
+	b.transfer(a, sdk.NewInt64Coin(ibc.VoucherDenom("chain-a", "stake"), 10), 1000)
+	step(t, r, a, b)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin(ibc.VoucherDenom("chain-b", "stake"), 20)),
+		a.coins(a.userAddress()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), b.coins(b.userAddress()))
+}
+
+func TestRelayerResume(t *testing.T) {
+	a, b, file, cleanup := setupChains(t)
+	defer cleanup()
+
+	a.transfer(b, sdk.NewInt64Coin("stake", 1), 1000)
+	a.transfer(b, sdk.NewInt64Coin("stake", 1), 1000)
+	r := newTestRelayer(t, a, b, file, DefaultConfig())
+	step(t, r, a, b)
+	step(t, r, a, b)
+	step(t, r, a, b)
+
+	// a restarted relayer skips the settled packets and relays the new ones
+	a.transfer(b, sdk.NewInt64Coin("stake", 1), 1000)
+	r = newTestRelayer(t, a, b, file, DefaultConfig())
+	require.Equal(t, Checkpoint{Settled: 2}, r.checkpoints.Get("chain-a", "chain-b"))
+	step(t, r, a, b)
+	require.Equal(t, uint64(3), b.ingressSequence(a))
+
+	step(t, r, a, b)
+	require.Equal(t, ibc.StatusAcknowledged, a.packetStatus(b, 2))
+	step(t, r, a, b)
+	require.Equal(t, Checkpoint{Settled: 3}, r.checkpoints.Get("chain-a", "chain-b"))
+}
+
+func TestRelayerBatches(t *testing.T) {
+	a, b, file, cleanup := setupChains(t)
+	defer cleanup()
+
+	for i := 0; i < 5; i++ {
+		a.transfer(b, sdk.NewInt64Coin("stake", 1), 1000)
+	}
+
+	config := DefaultConfig()
+	config.BatchSize = 2
+	r := newTestRelayer(t, a, b, file, config)
+
+	// each pass relays a transaction of at most two packets
+	for _, seq := range []uint64{2, 4, 5} {
+		step(t, r, a, b)
+		require.Equal(t, seq, b.ingressSequence(a))
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin(ibc.VoucherDenom("chain-a", "stake"), 5)),
+		b.coins(b.userAddress()))
+
+	// so does each pass relaying their acknowledgements
+	settled := r.checkpoints.Get("chain-a", "chain-b").Settled
+	for i := 0; i < 5 && settled < 5; i++ {
+		step(t, r, a, b)
+		cp := r.checkpoints.Get("chain-a", "chain-b")
+		require.True(t, cp.Settled <= settled+2)
+		settled = cp.Settled
+	}
+	require.Equal(t, uint64(5), settled)
+}
+
+func TestRelayerTimeout(t *testing.T) {
+	a, b, file, cleanup := setupChains(t)
+	defer cleanup()
+
+	// the packet times out at the height it is received at, after the block
+	// committing it on each chain
+	a.transfer(b, sdk.NewInt64Coin("stake", 10), uint64(b.app.LastBlockHeight()+2))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), a.coins(a.userAddress()))
+
+	r := newTestRelayer(t, a, b, file, DefaultConfig())
+	step(t, r, a, b)
+	require.Equal(t, uint64(1), b.ingressSequence(a))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), b.coins(b.userAddress()))
+
+	// the timeout refunds the sender
+	step(t, r, a, b)
+	require.Equal(t, ibc.StatusTimedOut, a.packetStatus(b, 0))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), a.coins(a.userAddress()))
+}
+
+// failingChain is a chain whose transactions fail.
+type failingChain struct {
+	*testChain
+}
+
+func (c failingChain) Broadcast(msgs []sdk.Msg) error {
+	return errors.New("broadcast failed")
+}
+
+func TestRelayerBackoff(t *testing.T) {
+	config := Config{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	require.Equal(t, time.Second, config.backoff(1))
+	require.Equal(t, 2*time.Second, config.backoff(2))
+	require.Equal(t, 8*time.Second, config.backoff(4))
+	require.Equal(t, 10*time.Second, config.backoff(5))
+	require.Equal(t, 10*time.Second, config.backoff(100))
+
+	a, b, file, cleanup := setupChains(t)
+	defer cleanup()
+
+	a.transfer(b, sdk.NewInt64Coin("stake", 1), 1000)
+	a.nextBlocks(1)
+	checkpoints, err := LoadCheckpointStore(file)
+	require.NoError(t, err)
+
+	config = DefaultConfig()
+	config.PollInterval = time.Millisecond
+	config.MinBackoff = time.Hour
+	config.MaxBackoff = time.Hour
+	r := NewRelayer(a.app.Cdc, a, failingChain{b}, checkpoints, config, NopMetrics(), log.NewNopLogger())
+
+	// the failing direction is not retried before its backoff
+	done := make(chan struct{})
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(done)
+	}()
+	r.Run(done)
+
+	require.Equal(t, 1, r.paths[0].failures)
+	require.True(t, r.paths[0].retryAt.After(time.Now().Add(59*time.Minute)))
+	require.Equal(t, 0, r.paths[1].failures)
+	require.Equal(t, uint64(0), b.ingressSequence(a))
+}
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+// CreateClientProposalReq defines the properties of a create client
+// proposal request's body.
+type CreateClientProposalReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req"`
+	Title          string         `json:"title"`           // Title of the proposal
+	Description    string         `json:"description"`     // Description of the proposal
+	Header         ibc.Header     `json:"header"`          // Header of the counterparty chain the client trusts
+	Proposer       sdk.AccAddress `json:"proposer"`        // Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit"` // Coins to add to the proposal's deposit
+}
+
+// ProposalRESTHandler returns the REST handler submitting create client
+// proposals, registered by the gov module under /gov/proposals/create_client
+func ProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create_client",
+		Handler:  postProposalHandlerFn(cliCtx, cdc),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateClientProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := ibc.NewCreateClientProposal(req.Title, req.Description, req.Header)
+		msg := gov.NewMsgSubmitProposal(content, req.Proposer, req.InitialDeposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
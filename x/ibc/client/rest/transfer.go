@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
+	ibcutils "github.com/cosmos/cosmos-sdk/x/ibc/client/utils"
 
 	"github.com/gorilla/mux"
 )
@@ -17,11 +19,16 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, kb keys.Keybase) {
 	r.HandleFunc("/ibc/{destchain}/{address}/send", TransferRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
+	r.HandleFunc("/ibc/packets/{destchain}/{sequence}", QueryPacketRequestHandlerFn(cliCtx, cdc)).Methods("GET")
 }
 
 type transferReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 	Amount  sdk.Coins    `json:"amount"`
+
+	// TimeoutHeight is the height of the destination chain from which the
+	// coins are refunded if the packet was not received.
+	TimeoutHeight uint64 `json:"timeout_height"`
 }
 
 // TransferRequestHandler - http request handler to transfer coins to a address
@@ -54,9 +61,37 @@ func TransferRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.
 			return
 		}
 
-		packet := ibc.NewIBCPacket(from, to, req.Amount, req.BaseReq.ChainID, destChainID)
+		packet := ibc.NewIBCPacket(from, to, req.Amount, req.BaseReq.ChainID, destChainID, req.TimeoutHeight)
 		msg := ibc.MsgIBCTransfer{IBCPacket: packet}
 
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// QueryPacketRequestHandlerFn - http request handler to query an outgoing IBC
+// packet and its status.
+func QueryPacketRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		destChainID := vars["destchain"]
+
+		sequence, err := strconv.ParseUint(vars["sequence"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		info, err := ibcutils.QueryPacketInfo(cliCtx.WithCodec(cdc), ibc.StoreKey, destChainID, sequence)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, info, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+// QueryHeader returns the header of the given height, or the latest one if
+// zero, of the chain behind the node, with its validator sets.
+func QueryHeader(node string, height int64) (header ibc.Header, err error) {
+	rpc, err := context.NewCLIContext().WithNodeURI(node).GetNode()
+	if err != nil {
+		return header, err
+	}
+
+	var h *int64
+	if height > 0 {
+		h = &height
+	}
+	commit, err := rpc.Commit(h)
+	if err != nil {
+		return header, err
+	}
+
+	height = commit.Height
+	vals, err := rpc.Validators(&height)
+	if err != nil {
+		return header, err
+	}
+
+	next := height + 1
+	nextVals, err := rpc.Validators(&next)
+	if err != nil {
+		return header, err
+	}
+
+	return ibc.NewHeader(
+		commit.SignedHeader,
+		tmtypes.NewValidatorSet(vals.Validators),
+		tmtypes.NewValidatorSet(nextVals.Validators),
+	), nil
+}
+
+// QueryWithProof queries a key of a store of the chain behind the node at the
+// given height, the latest one if zero, with the proof of its value.
+func QueryWithProof(node string, key []byte, storeName string, height int64) (res abci.ResponseQuery, err error) {
+	rpc, err := context.NewCLIContext().WithNodeURI(node).GetNode()
+	if err != nil {
+		return res, err
+	}
+
+	opts := rpcclient.ABCIQueryOptions{Height: height, Prove: true}
+	result, err := rpc.ABCIQueryWithOptions(fmt.Sprintf("/store/%s/key", storeName), key, opts)
+	if err != nil {
+		return res, err
+	}
+
+	res = result.Response
+	if !res.IsOK() {
+		return res, errors.New(res.Log)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+)
+
+// QueryPacketInfo queries an outgoing IBC packet and its status from the IBC
+// store.
+func QueryPacketInfo(cliCtx context.CLIContext, storeName, destChain string, sequence uint64) (info ibc.PacketInfo, err error) {
+	bz, err := cliCtx.QueryStore(ibc.EgressKey(destChain, sequence), storeName)
+	if err != nil {
+		return info, err
+	}
+	if len(bz) == 0 {
+		return info, fmt.Errorf("no packet %d to %s", sequence, destChain)
+	}
+
+	var packet ibc.IBCPacket
+	if err := cliCtx.Codec.UnmarshalBinaryLengthPrefixed(bz, &packet); err != nil {
+		return info, err
+	}
+
+	bz, err = cliCtx.QueryStore(ibc.PacketStatusKey(destChain, sequence), storeName)
+	if err != nil {
+		return info, err
+	}
+
+	status := ibc.StatusNil
+	if len(bz) == 1 {
+		status = ibc.PacketStatus(bz[0])
+	}
+
+	return ibc.NewPacketInfo(packet, sequence, status), nil
+}
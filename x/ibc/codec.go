@@ -8,4 +8,8 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgIBCTransfer{}, "cosmos-sdk/MsgIBCTransfer", nil)
 	cdc.RegisterConcrete(MsgIBCReceive{}, "cosmos-sdk/MsgIBCReceive", nil)
+	cdc.RegisterConcrete(MsgUpdateClient{}, "cosmos-sdk/MsgUpdateClient", nil)
+	cdc.RegisterConcrete(MsgIBCAcknowledgement{}, "cosmos-sdk/MsgIBCAcknowledgement", nil)
+	cdc.RegisterConcrete(MsgIBCTimeout{}, "cosmos-sdk/MsgIBCTimeout", nil)
+	cdc.RegisterConcrete(CreateClientProposal{}, "gov/CreateClientProposal", nil)
 }
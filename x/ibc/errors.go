@@ -1,6 +1,8 @@
 package ibc
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,9 +11,18 @@ const (
 	DefaultCodespace sdk.CodespaceType = "ibc"
 
 	// IBC errors reserve 200 - 299.
-	CodeInvalidSequence sdk.CodeType = 200
-	CodeIdenticalChains sdk.CodeType = 201
-	CodeUnknownRequest  sdk.CodeType = sdk.CodeUnknownRequest
+	CodeInvalidSequence    sdk.CodeType = 200
+	CodeIdenticalChains    sdk.CodeType = 201
+	CodeClientExists       sdk.CodeType = 202
+	CodeClientNotFound     sdk.CodeType = 203
+	CodeInvalidHeader      sdk.CodeType = 204
+	CodeInvalidProof       sdk.CodeType = 205
+	CodeInvalidPacket      sdk.CodeType = 206
+	CodeUnknownPacket      sdk.CodeType = 207
+	CodePacketNotActive    sdk.CodeType = 208
+	CodeNotTimedOut        sdk.CodeType = 209
+	CodeInsufficientEscrow sdk.CodeType = 210
+	CodeUnknownRequest     sdk.CodeType = sdk.CodeUnknownRequest
 )
 
 func codeToDefaultMsg(code sdk.CodeType) string {
@@ -20,6 +31,24 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 		return "invalid IBC packet sequence"
 	case CodeIdenticalChains:
 		return "source and destination chain cannot be identical"
+	case CodeClientExists:
+		return "light client of the chain already exists"
+	case CodeClientNotFound:
+		return "light client of the chain not found"
+	case CodeInvalidHeader:
+		return "invalid header of the chain"
+	case CodeInvalidProof:
+		return "invalid proof of the IBC packet"
+	case CodeInvalidPacket:
+		return "invalid IBC packet"
+	case CodeUnknownPacket:
+		return "unknown IBC packet"
+	case CodePacketNotActive:
+		return "IBC packet is no longer pending"
+	case CodeNotTimedOut:
+		return "IBC packet has not timed out"
+	case CodeInsufficientEscrow:
+		return "insufficient escrowed coins"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -32,6 +61,33 @@ func ErrInvalidSequence(codespace sdk.CodespaceType) sdk.Error {
 func ErrIdenticalChains(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeIdenticalChains, "")
 }
+func ErrClientExists(codespace sdk.CodespaceType, chainID string) sdk.Error {
+	return newError(codespace, CodeClientExists, fmt.Sprintf("light client of chain %s already exists", chainID))
+}
+func ErrClientNotFound(codespace sdk.CodespaceType, chainID string) sdk.Error {
+	return newError(codespace, CodeClientNotFound, fmt.Sprintf("light client of chain %s not found", chainID))
+}
+func ErrInvalidHeader(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidHeader, msg)
+}
+func ErrInvalidProof(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidProof, msg)
+}
+func ErrInvalidPacket(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidPacket, msg)
+}
+func ErrUnknownPacket(codespace sdk.CodespaceType, destChain string, sequence uint64) sdk.Error {
+	return newError(codespace, CodeUnknownPacket, fmt.Sprintf("no IBC packet %d to chain %s", sequence, destChain))
+}
+func ErrPacketNotActive(codespace sdk.CodespaceType, status PacketStatus) sdk.Error {
+	return newError(codespace, CodePacketNotActive, fmt.Sprintf("IBC packet is %s", status))
+}
+func ErrNotTimedOut(codespace sdk.CodespaceType, height int64, timeoutHeight uint64) sdk.Error {
+	return newError(codespace, CodeNotTimedOut, fmt.Sprintf("header height %d is below the timeout height %d", height, timeoutHeight))
+}
+func ErrInsufficientEscrow(codespace sdk.CodespaceType, chainID string, escrow, amt sdk.Coins) sdk.Error {
+	return newError(codespace, CodeInsufficientEscrow, fmt.Sprintf("%s escrowed for chain %s, cannot return %s", escrow, chainID, amt))
+}
 
 // -------------------------
 // Helpers
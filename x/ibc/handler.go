@@ -1,6 +1,8 @@
 package ibc
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,6 +13,12 @@ func NewHandler(ibcm Mapper, ck BankKeeper) sdk.Handler {
 			return handleIBCTransferMsg(ctx, ibcm, ck, msg)
 		case MsgIBCReceive:
 			return handleIBCReceiveMsg(ctx, ibcm, ck, msg)
+		case MsgIBCAcknowledgement:
+			return handleIBCAcknowledgementMsg(ctx, ibcm, msg)
+		case MsgIBCTimeout:
+			return handleIBCTimeoutMsg(ctx, ibcm, ck, msg)
+		case MsgUpdateClient:
+			return handleMsgUpdateClient(ctx, ibcm, msg)
 		default:
 			errMsg := "Unrecognized IBC Msg type: " + msg.Type()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -18,24 +26,35 @@ func NewHandler(ibcm Mapper, ck BankKeeper) sdk.Handler {
 	}
 }
 
-// MsgIBCTransfer deducts coins from the account and creates an egress IBC packet.
+// MsgIBCTransfer escrows or burns the coins of the account and creates an
+// egress IBC packet.
 func handleIBCTransferMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBCTransfer) sdk.Result {
 	packet := msg.IBCPacket
 
-	_, _, err := ck.SubtractCoins(ctx, packet.SrcAddr, packet.Coins)
+	err := sendCoins(ctx, ibcm, ck, packet)
 	if err != nil {
 		return err.Result()
 	}
 
-	err = ibcm.PostIBCPacket(ctx, packet)
+	seq, err := ibcm.PostIBCPacket(ctx, packet)
 	if err != nil {
 		return err.Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeySender, packet.SrcAddr.String(),
+			TagKeyDestChain, packet.DestChain,
+			TagKeySequence, strconv.FormatUint(seq, 10),
+		),
+	}
 }
 
-// MsgIBCReceive adds coins to the destination address and creates an ingress IBC packet.
+// MsgIBCReceive verifies the proof of the packet, credits the destination
+// address with vouchers or unescrowed coins and acknowledges the packet. A
+// packet received from its timeout height on, or addressed to a module account,
+// is skipped without acknowledgement, so that its sender can be refunded once
+// it times out. Failing the message instead would block the packets behind it.
 func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBCReceive) sdk.Result {
 	packet := msg.IBCPacket
 
@@ -44,13 +63,149 @@ func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBC
 		return ErrInvalidSequence(ibcm.codespace).Result()
 	}
 
-	// XXX Check that packet.Coins is valid and positive (nonzero)
-	_, _, err := ck.AddCoins(ctx, packet.DestAddr, packet.Coins)
+	err := ibcm.ReceiveIBCPacket(ctx, packet, msg.Sequence, msg.Proof, msg.Header)
 	if err != nil {
 		return err.Result()
 	}
 
 	ibcm.SetIngressSequence(ctx, packet.SrcChain, seq+1)
 
+	tags := sdk.NewTags(
+		TagKeySrcChain, packet.SrcChain,
+		TagKeySequence, strconv.FormatUint(seq, 10),
+	)
+	if packet.TimedOut(ctx.BlockHeight()) {
+		return sdk.Result{
+			Tags: tags.AppendTag(TagKeyStatus, StatusTimedOut.String()),
+		}
+	}
+
+	// coins received by module accounts would be booked as liquid supply
+	if ck.IsModuleAddress(ctx, packet.DestAddr) {
+		return sdk.Result{
+			Tags: tags.AppendTag(TagKeyStatus, StatusPending.String()),
+		}
+	}
+
+	err = receiveCoins(ctx, ibcm, ck, packet)
+	if err != nil {
+		return err.Result()
+	}
+
+	ibcm.WriteAcknowledgement(ctx, packet, seq)
+
+	return sdk.Result{
+		Tags: tags.AppendTags(sdk.NewTags(
+			TagKeyRecipient, packet.DestAddr.String(),
+			TagKeyStatus, StatusAcknowledged.String(),
+		)),
+	}
+}
+
+// MsgIBCAcknowledgement marks an outgoing packet as acknowledged.
+func handleIBCAcknowledgementMsg(ctx sdk.Context, ibcm Mapper, msg MsgIBCAcknowledgement) sdk.Result {
+	packet, err := ibcm.AcknowledgePacket(ctx, msg.DestChain, msg.Sequence, msg.Proof, msg.Header)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeySender, packet.SrcAddr.String(),
+			TagKeyDestChain, msg.DestChain,
+			TagKeySequence, strconv.FormatUint(msg.Sequence, 10),
+			TagKeyStatus, StatusAcknowledged.String(),
+		),
+	}
+}
+
+// MsgIBCTimeout marks an outgoing packet as timed out and refunds its sender.
+func handleIBCTimeoutMsg(ctx sdk.Context, ibcm Mapper, ck BankKeeper, msg MsgIBCTimeout) sdk.Result {
+	packet, err := ibcm.TimeoutPacket(ctx, msg.DestChain, msg.Sequence, msg.Proof, msg.Header)
+	if err != nil {
+		return err.Result()
+	}
+
+	err = refundCoins(ctx, ibcm, ck, packet)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			TagKeySender, packet.SrcAddr.String(),
+			TagKeyDestChain, msg.DestChain,
+			TagKeySequence, strconv.FormatUint(msg.Sequence, 10),
+			TagKeyStatus, StatusTimedOut.String(),
+		),
+	}
+}
+
+// MsgUpdateClient updates the light client of a counterparty chain.
+func handleMsgUpdateClient(ctx sdk.Context, ibcm Mapper, msg MsgUpdateClient) sdk.Result {
+	err := ibcm.UpdateClient(ctx, msg.Header)
+	if err != nil {
+		return err.Result()
+	}
+
 	return sdk.Result{}
 }
+
+// sendCoins moves the coins of a packet from its sender to the IBC module
+// account. Vouchers minted for coins of the destination chain are burned, as
+// the destination chain unescrows the coins they stand for, and the other
+// coins are escrowed for the destination chain.
+func sendCoins(ctx sdk.Context, ibcm Mapper, ck BankKeeper, packet IBCPacket) sdk.Error {
+	err := ck.SendCoinsFromAccountToModule(ctx, packet.SrcAddr, ModuleName, packet.Coins)
+	if err != nil {
+		return err
+	}
+
+	vouchers, escrowed := splitVouchers(packet.DestChain, packet.Coins)
+	if !vouchers.IsZero() {
+		if err := ck.BurnCoins(ctx, ModuleName, vouchers); err != nil {
+			return err
+		}
+	}
+
+	ibcm.addEscrow(ctx, packet.DestChain, escrowed)
+	return nil
+}
+
+// receiveCoins credits the recipient of a packet. Vouchers the source chain
+// minted for coins of this chain are exchanged for the coins escrowed for the
+// source chain, and vouchers are minted for the other coins.
+func receiveCoins(ctx sdk.Context, ibcm Mapper, ck BankKeeper, packet IBCPacket) sdk.Error {
+	returned, received := splitVouchers(packet.DestChain, packet.Coins)
+
+	unescrowed := returnedCoins(packet.DestChain, returned)
+	if err := ibcm.subtractEscrow(ctx, packet.SrcChain, unescrowed); err != nil {
+		return err
+	}
+
+	vouchers := mintedVouchers(packet.SrcChain, received)
+	if !vouchers.IsZero() {
+		if err := ck.MintCoins(ctx, ModuleName, vouchers); err != nil {
+			return err
+		}
+	}
+
+	return ck.SendCoinsFromModuleToAccount(ctx, ModuleName, packet.DestAddr, unescrowed.Add(vouchers))
+}
+
+// refundCoins returns the coins of a timed out packet to its sender, minting
+// back the burned vouchers and unescrowing the other coins.
+func refundCoins(ctx sdk.Context, ibcm Mapper, ck BankKeeper, packet IBCPacket) sdk.Error {
+	vouchers, escrowed := splitVouchers(packet.DestChain, packet.Coins)
+	if err := ibcm.subtractEscrow(ctx, packet.DestChain, escrowed); err != nil {
+		return err
+	}
+
+	if !vouchers.IsZero() {
+		if err := ck.MintCoins(ctx, ModuleName, vouchers); err != nil {
+			return err
+		}
+	}
+
+	return ck.SendCoinsFromModuleToAccount(ctx, ModuleName, packet.SrcAddr, packet.Coins)
+}
@@ -1,14 +1,18 @@
 package ibc
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/lite"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
@@ -16,15 +20,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 // AccountKeeper(/Keeper) and IBCMapper should use different StoreKey later
 
+// permissions of the IBC module account
+var maccPerms = map[string][]string{
+	ModuleName: {auth.Minter, auth.Burner},
+}
+
 type testInput struct {
 	cdc    *codec.Codec
 	ctx    sdk.Context
 	ak     auth.AccountKeeper
 	bk     bank.BaseKeeper
+	sk     supply.Keeper
 	ibcKey *sdk.KVStoreKey
 }
 
@@ -35,6 +46,7 @@ func setupTestInput() testInput {
 	ibcKey := sdk.NewKVStoreKey("ibcCapKey")
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
 	fckCapKey := sdk.NewKVStoreKey("fckCapKey")
+	keySupply := sdk.NewKVStoreKey("supply")
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 
@@ -42,6 +54,7 @@ func setupTestInput() testInput {
 	ms.MountStoreWithDB(ibcKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(fckCapKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
@@ -50,12 +63,13 @@ func setupTestInput() testInput {
 	ak := auth.NewAccountKeeper(
 		cdc, authCapKey, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount,
 	)
-	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	sk := supply.NewKeeper(cdc, keySupply)
+	bk := bank.NewBaseKeeper(ak, sk, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, maccPerms)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 
 	ak.SetParams(ctx, auth.DefaultParams())
 
-	return testInput{cdc: cdc, ctx: ctx, ak: ak, bk: bk, ibcKey: ibcKey}
+	return testInput{cdc: cdc, ctx: ctx, ak: ak, bk: bk, sk: sk, ibcKey: ibcKey}
 }
 
 func makeCodec() *codec.Codec {
@@ -66,10 +80,14 @@ func makeCodec() *codec.Codec {
 	cdc.RegisterConcrete(bank.MsgSend{}, "test/ibc/Send", nil)
 	cdc.RegisterConcrete(MsgIBCTransfer{}, "test/ibc/MsgIBCTransfer", nil)
 	cdc.RegisterConcrete(MsgIBCReceive{}, "test/ibc/MsgIBCReceive", nil)
+	cdc.RegisterConcrete(MsgUpdateClient{}, "test/ibc/MsgUpdateClient", nil)
+	cdc.RegisterConcrete(MsgIBCAcknowledgement{}, "test/ibc/MsgIBCAcknowledgement", nil)
+	cdc.RegisterConcrete(MsgIBCTimeout{}, "test/ibc/MsgIBCTimeout", nil)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/ibc/Account", nil)
+	cdc.RegisterConcrete(&auth.ModuleAccount{}, "test/ibc/ModuleAccount", nil)
 	codec.RegisterCrypto(cdc)
 
 	cdc.Seal()
@@ -87,6 +105,79 @@ func getCoins(ck bank.Keeper, ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins,
 	return coins, err
 }
 
+// testSourceChain is a counterparty chain committing the packets it sends to
+// its IBC store and signing its headers with a fixed validator set.
+type testSourceChain struct {
+	chainID string
+	ms      sdk.CommitMultiStore
+	key     *sdk.KVStoreKey
+	ibcm    Mapper
+	vals    *tmtypes.ValidatorSet
+	sign    func(height int64, appHash []byte) tmtypes.SignedHeader
+}
+
+// newTestSourceChain returns a source chain whose IBC store has the given name.
+func newTestSourceChain(cdc *codec.Codec, chainID, storeName string) *testSourceChain {
+	key := sdk.NewKVStoreKey(storeName)
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	ms.LoadLatestVersion()
+
+	keys := lite.GenSecpPrivKeys(4)
+	vals := keys.ToValidators(1, 0)
+
+	return &testSourceChain{
+		chainID: chainID,
+		ms:      ms,
+		key:     key,
+		ibcm:    NewMapper(cdc, key, DefaultCodespace),
+		vals:    vals,
+		sign: func(height int64, appHash []byte) tmtypes.SignedHeader {
+			return keys.GenSignedHeader(chainID, height, nil, vals, vals, appHash, nil, nil, 0, len(keys))
+		},
+	}
+}
+
+// header returns the header of the given height committing the app hash.
+func (c *testSourceChain) header(height int64, appHash []byte) Header {
+	return NewHeader(c.sign(height, appHash), c.vals, c.vals)
+}
+
+// commit applies the changes to the state of the chain and commits it.
+func (c *testSourceChain) commit(changes func(ctx sdk.Context)) {
+	ctx := sdk.NewContext(c.ms, abci.Header{ChainID: c.chainID}, false, log.NewNopLogger())
+	changes(ctx)
+	c.ms.Commit()
+}
+
+// prove returns the proof of the value of a key of the IBC store, or of its
+// absence, at the last committed height and the header committing it.
+func (c *testSourceChain) prove(t *testing.T, key []byte) (*merkle.Proof, Header) {
+	id := c.ms.LastCommitID()
+	res := c.ms.(sdk.Queryable).Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", c.key.Name()),
+		Data:   key,
+		Height: id.Version,
+		Prove:  true,
+	})
+	require.True(t, res.IsOK(), res.Log)
+
+	return res.Proof, c.header(id.Version+1, id.Hash)
+}
+
+// send posts a packet to the egress queue and commits it. It returns the proof
+// of the packet at the committed height and the header committing it.
+func (c *testSourceChain) send(t *testing.T, packet IBCPacket) (*merkle.Proof, Header) {
+	var index uint64
+	c.commit(func(ctx sdk.Context) {
+		var err sdk.Error
+		index, err = c.ibcm.PostIBCPacket(ctx, packet)
+		require.Nil(t, err)
+	})
+
+	return c.prove(t, EgressKey(packet.DestChain, index))
+}
+
 func TestIBC(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
@@ -104,11 +195,12 @@ func TestIBC(t *testing.T) {
 	ibcm := NewMapper(input.cdc, input.ibcKey, DefaultCodespace)
 	h := NewHandler(ibcm, input.bk)
 	packet := IBCPacket{
-		SrcAddr:   src,
-		DestAddr:  dest,
-		Coins:     mycoins,
-		SrcChain:  chainid,
-		DestChain: chainid,
+		SrcAddr:       src,
+		DestAddr:      dest,
+		Coins:         mycoins,
+		SrcChain:      ctx.ChainID(),
+		DestChain:     chainid,
+		TimeoutHeight: 100,
 	}
 
 	store := ctx.KVStore(input.ibcKey)
@@ -134,27 +226,373 @@ func TestIBC(t *testing.T) {
 	egl = ibcm.getEgressLength(store, chainid)
 	require.Equal(t, egl, uint64(1))
 
+	// receive the packet back from the counterparty chain
+	source := newTestSourceChain(input.cdc, chainid, input.ibcKey.Name())
+	packet = IBCPacket{
+		SrcAddr:       src,
+		DestAddr:      dest,
+		Coins:         mycoins,
+		SrcChain:      chainid,
+		DestChain:     ctx.ChainID(),
+		TimeoutHeight: 100,
+	}
+	proof, header := source.send(t, packet)
+
 	igs = ibcm.GetIngressSequence(ctx, chainid)
 	require.Equal(t, igs, uint64(0))
 
 	msg = MsgIBCReceive{
 		IBCPacket: packet,
+		Proof:     proof,
+		Header:    header,
 		Relayer:   src,
 		Sequence:  0,
 	}
+
+	// the light client of the chain must exist
 	res = h(ctx, msg)
-	require.True(t, res.IsOK())
+	require.Equal(t, CodeClientNotFound, res.Code)
+
+	require.Nil(t, ibcm.CreateClient(ctx, source.header(1, nil)))
+	err = ibcm.CreateClient(ctx, source.header(1, nil))
+	require.Equal(t, CodeClientExists, err.Code())
+
+	res = h(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
 
 	coins, err = getCoins(input.bk, ctx, dest)
 	require.Nil(t, err)
-	require.Equal(t, mycoins, coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(VoucherDenom(chainid, "mycoin"), 10)}, coins)
 
 	igs = ibcm.GetIngressSequence(ctx, chainid)
 	require.Equal(t, igs, uint64(1))
 
+	cs, found := ibcm.GetConsensusState(ctx, chainid)
+	require.True(t, found)
+	require.Equal(t, header.Height(), cs.Height)
+	require.Equal(t, header.AppHash(), ibcm.GetClientRoot(ctx, chainid, header.Height()))
+
 	res = h(ctx, msg)
 	require.False(t, res.IsOK())
 
 	igs = ibcm.GetIngressSequence(ctx, chainid)
 	require.Equal(t, igs, uint64(1))
+
+	// the proof must match the packet
+	msg = MsgIBCReceive{
+		IBCPacket: IBCPacket{
+			SrcAddr:       src,
+			DestAddr:      src,
+			Coins:         mycoins,
+			SrcChain:      chainid,
+			DestChain:     ctx.ChainID(),
+			TimeoutHeight: 100,
+		},
+		Proof:    proof,
+		Header:   header,
+		Relayer:  src,
+		Sequence: 1,
+	}
+	res = h(ctx, msg)
+	require.Equal(t, CodeInvalidProof, res.Code)
+
+	// the header must match the root tracked at its height
+	proof, header = source.send(t, packet)
+	msg = MsgIBCReceive{
+		IBCPacket: packet,
+		Proof:     proof,
+		Header:    source.header(header.Height()-1, header.AppHash()),
+		Relayer:   src,
+		Sequence:  1,
+	}
+	res = h(ctx, msg)
+	require.Equal(t, CodeInvalidHeader, res.Code)
+
+	// a packet proven by a header the client already tracks is accepted
+	res = h(ctx, MsgUpdateClient{Header: header, Signer: src})
+	require.True(t, res.IsOK(), res.Log)
+	msg = MsgIBCReceive{
+		IBCPacket: packet,
+		Proof:     proof,
+		Header:    header,
+		Relayer:   src,
+		Sequence:  1,
+	}
+	res = h(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+
+	igs = ibcm.GetIngressSequence(ctx, chainid)
+	require.Equal(t, igs, uint64(2))
+}
+
+func TestIBCReceiveTimedOut(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(100)
+
+	src := newAddress()
+	dest := newAddress()
+	chainid := "ibcchain"
+	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
+
+	ibcm := NewMapper(input.cdc, input.ibcKey, DefaultCodespace)
+	h := NewHandler(ibcm, input.bk)
+
+	source := newTestSourceChain(input.cdc, chainid, input.ibcKey.Name())
+	require.Nil(t, ibcm.CreateClient(ctx, source.header(1, nil)))
+
+	packet := NewIBCPacket(src, dest, mycoins, chainid, ctx.ChainID(), 100)
+	proof, header := source.send(t, packet)
+
+	// the timed out packet is skipped without acknowledgement
+	res := h(ctx, MsgIBCReceive{packet, proof, header, src, 0})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, uint64(1), ibcm.GetIngressSequence(ctx, chainid))
+	require.Nil(t, ctx.KVStore(input.ibcKey).Get(AckKey(chainid, 0)))
+
+	coins, err := getCoins(input.bk, ctx, dest)
+	require.Nil(t, err)
+	require.True(t, coins.IsZero())
+}
+
+func TestIBCReceiveModuleAccount(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	src := newAddress()
+	dest := newAddress()
+	chainid := "ibcchain"
+	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
+
+	feeCollector := auth.NewEmptyModuleAccount("fee_collector")
+	input.ak.SetAccount(ctx, input.ak.NewAccount(ctx, feeCollector))
+
+	ibcm := NewMapper(input.cdc, input.ibcKey, DefaultCodespace)
+	h := NewHandler(ibcm, input.bk)
+	escrowInvariant := EscrowInvariant(ibcm, input.bk)
+	supplyInvariant := supply.TotalSupplyInvariant(input.sk, input.ak)
+
+	source := newTestSourceChain(input.cdc, chainid, input.ibcKey.Name())
+	require.Nil(t, ibcm.CreateClient(ctx, source.header(1, nil)))
+
+	receive := func(packet IBCPacket, sequence uint64) sdk.Result {
+		proof, header := source.send(t, packet)
+		return h(ctx, MsgIBCReceive{packet, proof, header, src, sequence})
+	}
+
+	// packets addressed to module accounts are skipped without acknowledgement
+	moduleAddrs := []sdk.AccAddress{auth.NewModuleAddress(ModuleName), feeCollector.GetAddress()}
+	for i, addr := range moduleAddrs {
+		res := receive(NewIBCPacket(src, addr, mycoins, chainid, ctx.ChainID(), 100), uint64(i))
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, uint64(i+1), ibcm.GetIngressSequence(ctx, chainid))
+		require.Nil(t, ctx.KVStore(input.ibcKey).Get(AckKey(chainid, uint64(i))))
+		require.True(t, input.bk.GetCoins(ctx, addr).IsZero())
+
+		require.Nil(t, escrowInvariant(ctx))
+		require.Nil(t, supplyInvariant(ctx))
+	}
+
+	// the packets behind them are still received
+	res := receive(NewIBCPacket(src, dest, mycoins, chainid, ctx.ChainID(), 100), 2)
+	require.True(t, res.IsOK(), res.Log)
+	require.NotNil(t, ctx.KVStore(input.ibcKey).Get(AckKey(chainid, 2)))
+	require.Equal(t, int64(10), input.bk.GetCoins(ctx, dest).AmountOf(VoucherDenom(chainid, "mycoin")).Int64())
+
+	require.Nil(t, escrowInvariant(ctx))
+	require.Nil(t, supplyInvariant(ctx))
+}
+
+func TestIBCAcknowledgementAndTimeout(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	src := newAddress()
+	dest := newAddress()
+	chainid := "ibcchain"
+	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
+
+	_, _, err := input.bk.AddCoins(ctx, src, mycoins.Add(mycoins))
+	require.Nil(t, err)
+
+	ibcm := NewMapper(input.cdc, input.ibcKey, DefaultCodespace)
+	h := NewHandler(ibcm, input.bk)
+
+	dst := newTestSourceChain(input.cdc, chainid, input.ibcKey.Name())
+	require.Nil(t, ibcm.CreateClient(ctx, dst.header(1, nil)))
+
+	// send two packets timing out at height 10 of the destination chain
+	packet := NewIBCPacket(src, dest, mycoins, ctx.ChainID(), chainid, 10)
+	for i := 0; i < 2; i++ {
+		res := h(ctx, MsgIBCTransfer{packet})
+		require.True(t, res.IsOK(), res.Log)
+
+		info, found := ibcm.GetPacketInfo(ctx, chainid, uint64(i))
+		require.True(t, found)
+		require.Equal(t, NewPacketInfo(packet, uint64(i), StatusPending), info)
+	}
+
+	coins, err := getCoins(input.bk, ctx, src)
+	require.Nil(t, err)
+	require.True(t, coins.IsZero())
+
+	_, found := ibcm.GetPacketInfo(ctx, chainid, 2)
+	require.False(t, found)
+
+	// the destination chain receives the first packet only
+	dst.commit(func(dstCtx sdk.Context) {
+		dst.ibcm.WriteAcknowledgement(dstCtx, packet, 0)
+	})
+	proof, header := dst.prove(t, AckKey(ctx.ChainID(), 0))
+
+	res := h(ctx, MsgIBCTimeout{chainid, 0, proof, header, src})
+	require.Equal(t, CodeNotTimedOut, res.Code)
+
+	res = h(ctx, MsgIBCAcknowledgement{chainid, 0, proof, header, src})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, StatusAcknowledged, ibcm.GetPacketStatus(ctx, chainid, 0))
+
+	res = h(ctx, MsgIBCAcknowledgement{chainid, 0, proof, header, src})
+	require.Equal(t, CodePacketNotActive, res.Code)
+
+	// the second packet can't be timed out before its timeout height
+	proof, header = dst.prove(t, AckKey(ctx.ChainID(), 1))
+
+	res = h(ctx, MsgIBCAcknowledgement{chainid, 1, proof, header, src})
+	require.Equal(t, CodeInvalidProof, res.Code)
+
+	res = h(ctx, MsgIBCTimeout{chainid, 1, proof, header, src})
+	require.Equal(t, CodeNotTimedOut, res.Code)
+
+	for dst.ms.LastCommitID().Version+1 < 10 {
+		dst.commit(func(sdk.Context) {})
+	}
+	proof, header = dst.prove(t, AckKey(ctx.ChainID(), 1))
+
+	res = h(ctx, MsgIBCTimeout{chainid, 1, proof, header, src})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, StatusTimedOut, ibcm.GetPacketStatus(ctx, chainid, 1))
+
+	coins, err = getCoins(input.bk, ctx, src)
+	require.Nil(t, err)
+	require.Equal(t, mycoins, coins)
+
+	// each packet is settled once
+	res = h(ctx, MsgIBCTimeout{chainid, 1, proof, header, src})
+	require.Equal(t, CodePacketNotActive, res.Code)
+
+	res = h(ctx, MsgIBCTimeout{chainid, 0, proof, header, src})
+	require.Equal(t, CodePacketNotActive, res.Code)
+
+	res = h(ctx, MsgIBCTimeout{chainid, 2, proof, header, src})
+	require.Equal(t, CodeUnknownPacket, res.Code)
+}
+
+func TestIBCVouchers(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	src := newAddress()
+	dest := newAddress()
+	chainid := "ibcchain"
+	moduleAddr := auth.NewModuleAddress(ModuleName)
+	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
+	returned := VoucherDenom(ctx.ChainID(), "mycoin")
+	voucher := VoucherDenom(chainid, "atom")
+
+	_, _, err := input.bk.AddCoins(ctx, src, mycoins)
+	require.Nil(t, err)
+
+	ibcm := NewMapper(input.cdc, input.ibcKey, DefaultCodespace)
+	h := NewHandler(ibcm, input.bk)
+	invariant := EscrowInvariant(ibcm, input.bk)
+
+	source := newTestSourceChain(input.cdc, chainid, input.ibcKey.Name())
+	require.Nil(t, ibcm.CreateClient(ctx, source.header(1, nil)))
+
+	receive := func(packet IBCPacket, sequence uint64) sdk.Result {
+		proof, header := source.send(t, packet)
+		return h(ctx, MsgIBCReceive{packet, proof, header, src, sequence})
+	}
+
+	// coins of this chain are escrowed
+	res := h(ctx, MsgIBCTransfer{NewIBCPacket(src, dest, mycoins, ctx.ChainID(), chainid, 100)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, mycoins, ibcm.GetEscrow(ctx, chainid))
+	require.Equal(t, mycoins, input.bk.GetCoins(ctx, moduleAddr))
+	require.Nil(t, invariant(ctx))
+
+	// vouchers of coins of this chain returned by the chain are unescrowed
+	packet := NewIBCPacket(src, dest, sdk.Coins{sdk.NewInt64Coin(returned, 4)}, chainid, ctx.ChainID(), 100)
+	res = receive(packet, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("mycoin", 4)}, input.bk.GetCoins(ctx, dest))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("mycoin", 6)}, ibcm.GetEscrow(ctx, chainid))
+	require.Nil(t, invariant(ctx))
+
+	// vouchers are minted for coins of the chain
+	packet = NewIBCPacket(src, dest, sdk.Coins{sdk.NewInt64Coin("atom", 5)}, chainid, ctx.ChainID(), 100)
+	res = receive(packet, 1)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(5), input.bk.GetCoins(ctx, dest).AmountOf(voucher).Int64())
+	require.Nil(t, invariant(ctx))
+
+	// vouchers sent back to the chain are burned and minted back on timeout
+	vouchers := sdk.Coins{sdk.NewInt64Coin(voucher, 2)}
+	res = h(ctx, MsgIBCTransfer{NewIBCPacket(dest, src, vouchers, ctx.ChainID(), chainid, 10)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(3), input.bk.GetCoins(ctx, dest).AmountOf(voucher).Int64())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("mycoin", 6)}, input.bk.GetCoins(ctx, moduleAddr))
+	require.Nil(t, invariant(ctx))
+
+	for source.ms.LastCommitID().Version+1 < 10 {
+		source.commit(func(sdk.Context) {})
+	}
+	proof, header := source.prove(t, AckKey(ctx.ChainID(), 1))
+	res = h(ctx, MsgIBCTimeout{chainid, 1, proof, header, src})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(5), input.bk.GetCoins(ctx, dest).AmountOf(voucher).Int64())
+	require.Nil(t, invariant(ctx))
+
+	// no more coins than escrowed can be returned
+	packet = NewIBCPacket(src, dest, sdk.Coins{sdk.NewInt64Coin(returned, 7)}, chainid, ctx.ChainID(), 100)
+	res = receive(packet, 2)
+	require.Equal(t, CodeInsufficientEscrow, res.Code)
+
+	// escrowed coins not held by the module account break the invariant
+	ibcm.addEscrow(ctx, chainid, mycoins)
+	require.NotNil(t, invariant(ctx))
+}
+
+func TestIBCVouchersUpperCaseChainID(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	src := newAddress()
+	dest := newAddress()
+	// the default chain IDs of gaiad init contain upper case characters
+	chainid := "test-chain-AbC12x"
+	voucher := VoucherDenom(chainid, "stake")
+
+	ibcm := NewMapper(input.cdc, input.ibcKey, DefaultCodespace)
+	h := NewHandler(ibcm, input.bk)
+
+	source := newTestSourceChain(input.cdc, chainid, input.ibcKey.Name())
+	require.Nil(t, ibcm.CreateClient(ctx, source.header(1, nil)))
+
+	// vouchers are minted for coins of the chain
+	packet := NewIBCPacket(src, dest, sdk.Coins{sdk.NewInt64Coin("stake", 5)}, chainid, ctx.ChainID(), 100)
+	proof, header := source.send(t, packet)
+	res := h(ctx, MsgIBCReceive{packet, proof, header, src, 0})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(5), input.bk.GetCoins(ctx, dest).AmountOf(voucher).Int64())
+
+	// the vouchers can be sent on this chain and back to the chain
+	vouchers := sdk.Coins{sdk.NewInt64Coin(voucher, 2)}
+	require.Nil(t, bank.NewMsgSend(dest, src, vouchers).ValidateBasic())
+
+	msg := MsgIBCTransfer{NewIBCPacket(dest, src, vouchers, ctx.ChainID(), chainid, 100)}
+	require.Nil(t, msg.ValidateBasic())
+	res = h(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(3), input.bk.GetCoins(ctx, dest).AmountOf(voucher).Int64())
 }
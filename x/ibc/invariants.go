@@ -0,0 +1,46 @@
+package ibc
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// RegisterInvariants registers the IBC module invariants
+func RegisterInvariants(ir sdk.InvariantRouter, ibcm Mapper, bk BankKeeper) {
+	ir.RegisterRoute(ModuleName, "escrow",
+		EscrowInvariant(ibcm, bk))
+}
+
+// EscrowInvariant checks that the coins escrowed for each chain are valid and
+// that the IBC module account holds exactly the coins escrowed for all chains,
+// as vouchers are burned as soon as they are sent back and minted only to be
+// credited to their recipient.
+func EscrowInvariant(ibcm Mapper, bk BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		var err error
+		escrowed := sdk.NewCoins()
+		ibcm.IterateEscrows(ctx, func(chainID string, escrow sdk.Coins) bool {
+			if !escrow.IsValid() {
+				err = fmt.Errorf("invalid coins %s escrowed for chain %s", escrow, chainID)
+				return true
+			}
+			escrowed = escrowed.Add(escrow)
+			return false
+		})
+		if err != nil {
+			return err
+		}
+
+		held := bk.GetCoins(ctx, auth.NewModuleAddress(ModuleName))
+		diff, hasNeg := held.SafeSub(escrowed)
+		if hasNeg || !diff.IsZero() {
+			return fmt.Errorf("escrow invariance:\n"+
+				"\tIBC module account balance: %v\n"+
+				"\tsum of escrowed coins: %v", held, escrowed)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+package ibc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/lite"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// ------------------------------
+// Header
+
+// Header is a header of a counterparty chain with the commit signing it, the
+// validator set which signed the commit and the validator set of the next
+// height. A relayer submits it to update the light client of the chain.
+type Header struct {
+	SignedHeader     tmtypes.SignedHeader  `json:"signed_header"`
+	ValidatorSet     *tmtypes.ValidatorSet `json:"validator_set"`
+	NextValidatorSet *tmtypes.ValidatorSet `json:"next_validator_set"`
+}
+
+// NewHeader returns a new header of a counterparty chain.
+func NewHeader(signedHeader tmtypes.SignedHeader, vals, nextVals *tmtypes.ValidatorSet) Header {
+	return Header{
+		SignedHeader:     signedHeader,
+		ValidatorSet:     vals,
+		NextValidatorSet: nextVals,
+	}
+}
+
+// nolint
+func (h Header) ChainID() string { return h.SignedHeader.ChainID }
+func (h Header) Height() int64   { return h.SignedHeader.Height }
+func (h Header) AppHash() []byte { return h.SignedHeader.AppHash }
+
+// ValidateBasic checks that the header is consistent with its validator sets
+// and that over 2/3 of its validator set signed it. It does not check that the
+// validator set is trusted.
+func (h Header) ValidateBasic() error {
+	if h.SignedHeader.Header == nil || h.SignedHeader.Commit == nil {
+		return errors.New("header and commit must be set")
+	}
+	if h.ValidatorSet == nil || h.NextValidatorSet == nil {
+		return errors.New("validator set and next validator set must be set")
+	}
+
+	fc := lite.NewFullCommit(h.SignedHeader, h.ValidatorSet, h.NextValidatorSet)
+	return fc.ValidateFull(h.ChainID())
+}
+
+// ------------------------------
+// ConsensusState
+
+// ConsensusState is the state of the light client of a counterparty chain:
+// the latest header it verified, the application hash committed by that header
+// and the validator set trusted to sign the next one.
+type ConsensusState struct {
+	ChainID          string                `json:"chain_id"`
+	Height           int64                 `json:"height"`
+	Root             []byte                `json:"root"`
+	NextValidatorSet *tmtypes.ValidatorSet `json:"next_validator_set"`
+}
+
+// NewConsensusState returns the consensus state of a light client trusting the
+// given header.
+func NewConsensusState(header Header) ConsensusState {
+	return ConsensusState{
+		ChainID:          header.ChainID(),
+		Height:           header.Height(),
+		Root:             header.AppHash(),
+		NextValidatorSet: header.NextValidatorSet,
+	}
+}
+
+// Update verifies a header of a later height against the consensus state and
+// returns the consensus state trusting it.
+//
+// The header of the next height must be signed by the trusted validator set.
+// A header skipping heights must be signed by over 2/3 of the trusted validator
+// set as well as by its own validator set.
+func (cs ConsensusState) Update(header Header) (ConsensusState, error) {
+	if header.ChainID() != cs.ChainID {
+		return cs, fmt.Errorf("header belongs to chain %s, expected %s", header.ChainID(), cs.ChainID)
+	}
+	if header.Height() <= cs.Height {
+		return cs, fmt.Errorf("header height %d is not above the client height %d", header.Height(), cs.Height)
+	}
+	if err := header.ValidateBasic(); err != nil {
+		return cs, err
+	}
+
+	if header.Height() == cs.Height+1 {
+		if !bytes.Equal(header.SignedHeader.ValidatorsHash, cs.NextValidatorSet.Hash()) {
+			return cs, fmt.Errorf("header has validators hash %X, expected %X",
+				header.SignedHeader.ValidatorsHash, cs.NextValidatorSet.Hash())
+		}
+	} else {
+		commit := header.SignedHeader.Commit
+		err := cs.NextValidatorSet.VerifyFutureCommit(header.ValidatorSet, cs.ChainID,
+			commit.BlockID, header.Height(), commit)
+		if err != nil {
+			return cs, err
+		}
+	}
+
+	return NewConsensusState(header), nil
+}
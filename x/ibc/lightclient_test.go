@@ -0,0 +1,71 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/lite"
+)
+
+func TestConsensusStateUpdate(t *testing.T) {
+	chainID := "source-chain"
+	appHash := []byte("app hash")
+
+	keys := lite.GenSecpPrivKeys(4)
+	vals := keys.ToValidators(1, 0)
+
+	// the last validator is replaced, the others keep 3/4 of the power
+	changedKeys := keys.Change(3)
+	changedVals := changedKeys.ToValidators(1, 0)
+
+	// no validator is kept
+	newKeys := lite.GenSecpPrivKeys(4)
+	newVals := newKeys.ToValidators(1, 0)
+
+	header := NewHeader(keys.GenSignedHeader(chainID, 1, nil, vals, vals, nil, nil, nil, 0, 4), vals, vals)
+	require.NoError(t, header.ValidateBasic())
+	cs := NewConsensusState(header)
+
+	cases := []struct {
+		name   string
+		header Header
+		valid  bool
+	}{
+		{"next height",
+			NewHeader(keys.GenSignedHeader(chainID, 2, nil, vals, vals, appHash, nil, nil, 0, 4), vals, vals), true},
+		{"next height signed by 3/4",
+			NewHeader(keys.GenSignedHeader(chainID, 2, nil, vals, vals, appHash, nil, nil, 0, 3), vals, vals), true},
+		{"next height signed by 1/2",
+			NewHeader(keys.GenSignedHeader(chainID, 2, nil, vals, vals, appHash, nil, nil, 0, 2), vals, vals), false},
+		{"next height with an untrusted validator set",
+			NewHeader(changedKeys.GenSignedHeader(chainID, 2, nil, changedVals, changedVals, appHash, nil, nil, 0, 4), changedVals, changedVals), false},
+		{"skipped heights with a kept validator set",
+			NewHeader(keys.GenSignedHeader(chainID, 5, nil, vals, vals, appHash, nil, nil, 0, 4), vals, vals), true},
+		{"skipped heights with a changed validator set",
+			NewHeader(changedKeys.GenSignedHeader(chainID, 5, nil, changedVals, changedVals, appHash, nil, nil, 0, 4), changedVals, changedVals), true},
+		{"skipped heights with a new validator set",
+			NewHeader(newKeys.GenSignedHeader(chainID, 5, nil, newVals, newVals, appHash, nil, nil, 0, 4), newVals, newVals), false},
+		{"validator set not matching the header",
+			NewHeader(keys.GenSignedHeader(chainID, 2, nil, vals, vals, appHash, nil, nil, 0, 4), changedVals, vals), false},
+		{"same height",
+			NewHeader(keys.GenSignedHeader(chainID, 1, nil, vals, vals, appHash, nil, nil, 0, 4), vals, vals), false},
+		{"other chain",
+			NewHeader(keys.GenSignedHeader("other-chain", 2, nil, vals, vals, appHash, nil, nil, 0, 4), vals, vals), false},
+		{"missing validator set",
+			NewHeader(keys.GenSignedHeader(chainID, 2, nil, vals, vals, appHash, nil, nil, 0, 4), nil, vals), false},
+	}
+
+	for _, tc := range cases {
+		updated, err := cs.Update(tc.header)
+		if !tc.valid {
+			require.Error(t, err, tc.name)
+			require.Equal(t, cs, updated, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.header.Height(), updated.Height, tc.name)
+		require.Equal(t, appHash, updated.Root, tc.name)
+		require.Equal(t, tc.header.NextValidatorSet, updated.NextValidatorSet, tc.name)
+	}
+}
@@ -1,9 +1,14 @@
 package ibc
 
 import (
+	"bytes"
 	"fmt"
 
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
 	codec "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -28,8 +33,10 @@ func NewMapper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType)
 // XXX: This is not the public API. This will change in MVP2 and will henceforth
 // only be invoked from another module directly and not through a user
 // transaction.
-// TODO: Handle invalid IBC packets and return errors.
-func (ibcm Mapper) PostIBCPacket(ctx sdk.Context, packet IBCPacket) sdk.Error {
+//
+// PostIBCPacket appends the packet to the egress queue of its destination
+// chain as pending and returns its sequence.
+func (ibcm Mapper) PostIBCPacket(ctx sdk.Context, packet IBCPacket) (uint64, sdk.Error) {
 	// write everything into the state
 	store := ctx.KVStore(ibcm.key)
 	index := ibcm.getEgressLength(store, packet.DestChain)
@@ -44,16 +51,180 @@ func (ibcm Mapper) PostIBCPacket(ctx sdk.Context, packet IBCPacket) sdk.Error {
 		panic(err)
 	}
 	store.Set(EgressLengthKey(packet.DestChain), bz)
+	ibcm.setPacketStatus(ctx, packet.DestChain, index, StatusPending)
 
-	return nil
+	return index, nil
 }
 
 // XXX: In the future every module is able to register it's own handler for
 // handling it's own IBC packets. The "ibc" handler will only route the packets
 // to the appropriate callbacks.
-// XXX: For now this handles all interactions with the CoinKeeper.
-// XXX: This needs to do some authentication checking.
-func (ibcm Mapper) ReceiveIBCPacket(ctx sdk.Context, packet IBCPacket) sdk.Error {
+//
+// ReceiveIBCPacket checks that the source chain committed the packet to its
+// egress queue at the given index. The proof must prove the packet against the
+// application hash of the header, which must be verified by the light client
+// of the source chain, updating it, or already tracked by it. As the app hash
+// of a header commits the state of the previous height, the proof is the one of
+// a query at the height below the header.
+func (ibcm Mapper) ReceiveIBCPacket(ctx sdk.Context, packet IBCPacket, sequence uint64,
+	proof *merkle.Proof, header Header) sdk.Error {
+
+	if packet.DestChain != ctx.ChainID() {
+		return ErrInvalidPacket(ibcm.codespace, fmt.Sprintf("packet is destined to chain %s", packet.DestChain))
+	}
+
+	root, err := ibcm.verifyHeader(ctx, packet.SrcChain, header)
+	if err != nil {
+		return err
+	}
+
+	value := marshalBinaryPanic(ibcm.cdc, packet)
+	return ibcm.verifyProof(proof, root, EgressKey(packet.DestChain, sequence), value)
+}
+
+// WriteAcknowledgement writes the acknowledgement of a received packet, which
+// the source chain of the packet is then given the proof of.
+func (ibcm Mapper) WriteAcknowledgement(ctx sdk.Context, packet IBCPacket, sequence uint64) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(AckKey(packet.SrcChain, sequence), ibcm.acknowledgement(packet))
+}
+
+// AcknowledgePacket checks that the destination chain of a pending outgoing
+// packet acknowledged it and marks it as acknowledged. The proof must prove the
+// acknowledgement against the application hash of the header as in
+// ReceiveIBCPacket.
+func (ibcm Mapper) AcknowledgePacket(ctx sdk.Context, destChain string, sequence uint64,
+	proof *merkle.Proof, header Header) (IBCPacket, sdk.Error) {
+
+	packet, err := ibcm.getPendingPacket(ctx, destChain, sequence)
+	if err != nil {
+		return packet, err
+	}
+
+	root, err := ibcm.verifyHeader(ctx, destChain, header)
+	if err != nil {
+		return packet, err
+	}
+
+	err = ibcm.verifyProof(proof, root, AckKey(ctx.ChainID(), sequence), ibcm.acknowledgement(packet))
+	if err != nil {
+		return packet, err
+	}
+
+	ibcm.setPacketStatus(ctx, destChain, sequence, StatusAcknowledged)
+	return packet, nil
+}
+
+// TimeoutPacket checks that the destination chain of a pending outgoing packet
+// did not acknowledge it before its timeout height and marks it as timed out.
+// The proof must prove the absence of the acknowledgement against the
+// application hash of a header at or above the timeout height as in
+// ReceiveIBCPacket: since the state it commits includes every height below the
+// timeout height, the packet can never be received.
+func (ibcm Mapper) TimeoutPacket(ctx sdk.Context, destChain string, sequence uint64,
+	proof *merkle.Proof, header Header) (IBCPacket, sdk.Error) {
+
+	packet, err := ibcm.getPendingPacket(ctx, destChain, sequence)
+	if err != nil {
+		return packet, err
+	}
+
+	if !packet.TimedOut(header.Height()) {
+		return packet, ErrNotTimedOut(ibcm.codespace, header.Height(), packet.TimeoutHeight)
+	}
+
+	root, err := ibcm.verifyHeader(ctx, destChain, header)
+	if err != nil {
+		return packet, err
+	}
+
+	err = ibcm.verifyProof(proof, root, AckKey(ctx.ChainID(), sequence), nil)
+	if err != nil {
+		return packet, err
+	}
+
+	ibcm.setPacketStatus(ctx, destChain, sequence, StatusTimedOut)
+	return packet, nil
+}
+
+// verifyHeader returns the app hash of a header of a counterparty chain after
+// verifying it with the light client of the chain, or checking that the client
+// tracks it.
+func (ibcm Mapper) verifyHeader(ctx sdk.Context, chainID string, header Header) ([]byte, sdk.Error) {
+	if header.ChainID() != chainID {
+		return nil, ErrInvalidHeader(ibcm.codespace, fmt.Sprintf("header belongs to chain %s, expected %s", header.ChainID(), chainID))
+	}
+
+	root := ibcm.GetClientRoot(ctx, header.ChainID(), header.Height())
+	if root == nil {
+		if err := ibcm.UpdateClient(ctx, header); err != nil {
+			return nil, err
+		}
+		return header.AppHash(), nil
+	}
+
+	if !bytes.Equal(root, header.AppHash()) {
+		return nil, ErrInvalidHeader(ibcm.codespace, fmt.Sprintf("header has app hash %X, the client tracks %X", header.AppHash(), root))
+	}
+	return root, nil
+}
+
+// verifyProof checks the proof of the value of a key of the IBC store of a
+// counterparty chain, or of its absence if the value is nil, against the root.
+func (ibcm Mapper) verifyProof(proof *merkle.Proof, root, key, value []byte) sdk.Error {
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(ibcm.key.Name()), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+	var err error
+	if value == nil {
+		err = prt.VerifyAbsence(proof, root, kp.String())
+	} else {
+		err = prt.VerifyValue(proof, root, kp.String(), value)
+	}
+	if err != nil {
+		return ErrInvalidProof(ibcm.codespace, err.Error())
+	}
+
+	return nil
+}
+
+// acknowledgement returns the acknowledgement of a packet, the hash of its
+// encoding.
+func (ibcm Mapper) acknowledgement(packet IBCPacket) []byte {
+	return tmhash.Sum(marshalBinaryPanic(ibcm.cdc, packet))
+}
+
+// CreateClient creates the light client of a counterparty chain trusting the
+// given header. Nothing but the header itself vouches for it, so it must only
+// be called for headers trusted by governance, see CreateClientProposal.
+func (ibcm Mapper) CreateClient(ctx sdk.Context, header Header) sdk.Error {
+	if _, found := ibcm.GetConsensusState(ctx, header.ChainID()); found {
+		return ErrClientExists(ibcm.codespace, header.ChainID())
+	}
+	if err := header.ValidateBasic(); err != nil {
+		return ErrInvalidHeader(ibcm.codespace, err.Error())
+	}
+
+	ibcm.setConsensusState(ctx, NewConsensusState(header))
+	return nil
+}
+
+// UpdateClient verifies a header of a counterparty chain with its light client
+// and updates the client to trust it.
+func (ibcm Mapper) UpdateClient(ctx sdk.Context, header Header) sdk.Error {
+	cs, found := ibcm.GetConsensusState(ctx, header.ChainID())
+	if !found {
+		return ErrClientNotFound(ibcm.codespace, header.ChainID())
+	}
+
+	cs, err := cs.Update(header)
+	if err != nil {
+		return ErrInvalidHeader(ibcm.codespace, err.Error())
+	}
+
+	ibcm.setConsensusState(ctx, cs)
 	return nil
 }
 
@@ -101,6 +272,134 @@ func (ibcm Mapper) SetIngressSequence(ctx sdk.Context, srcChain string, sequence
 	store.Set(key, bz)
 }
 
+// GetConsensusState returns the consensus state of the light client of a
+// counterparty chain.
+func (ibcm Mapper) GetConsensusState(ctx sdk.Context, chainID string) (cs ConsensusState, found bool) {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(ClientKey(chainID))
+	if bz == nil {
+		return cs, false
+	}
+
+	unmarshalBinaryPanic(ibcm.cdc, bz, &cs)
+	return cs, true
+}
+
+// GetClientRoot returns the application hash of the header of the given height
+// verified by the light client of a counterparty chain, or nil if the client
+// skipped or did not reach that height.
+func (ibcm Mapper) GetClientRoot(ctx sdk.Context, chainID string, height int64) []byte {
+	store := ctx.KVStore(ibcm.key)
+	return store.Get(ClientRootKey(chainID, height))
+}
+
+// Stores the consensus state and the root of its height.
+func (ibcm Mapper) setConsensusState(ctx sdk.Context, cs ConsensusState) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(ClientKey(cs.ChainID), marshalBinaryPanic(ibcm.cdc, cs))
+
+	// the first header of a chain commits no state
+	if len(cs.Root) > 0 {
+		store.Set(ClientRootKey(cs.ChainID, cs.Height), cs.Root)
+	}
+}
+
+// GetPacketInfo returns an outgoing IBC packet with its status.
+func (ibcm Mapper) GetPacketInfo(ctx sdk.Context, destChain string, sequence uint64) (info PacketInfo, found bool) {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(EgressKey(destChain, sequence))
+	if bz == nil {
+		return info, false
+	}
+
+	var packet IBCPacket
+	unmarshalBinaryPanic(ibcm.cdc, bz, &packet)
+	return NewPacketInfo(packet, sequence, ibcm.GetPacketStatus(ctx, destChain, sequence)), true
+}
+
+// GetPacketStatus returns the status of an outgoing IBC packet.
+func (ibcm Mapper) GetPacketStatus(ctx sdk.Context, destChain string, sequence uint64) PacketStatus {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(PacketStatusKey(destChain, sequence))
+	if bz == nil {
+		return StatusNil
+	}
+	return PacketStatus(bz[0])
+}
+
+func (ibcm Mapper) setPacketStatus(ctx sdk.Context, destChain string, sequence uint64, status PacketStatus) {
+	store := ctx.KVStore(ibcm.key)
+	store.Set(PacketStatusKey(destChain, sequence), []byte{byte(status)})
+}
+
+// Retrieves a pending outgoing IBC packet.
+func (ibcm Mapper) getPendingPacket(ctx sdk.Context, destChain string, sequence uint64) (IBCPacket, sdk.Error) {
+	info, found := ibcm.GetPacketInfo(ctx, destChain, sequence)
+	if !found {
+		return info.Packet, ErrUnknownPacket(ibcm.codespace, destChain, sequence)
+	}
+	if info.Status != StatusPending {
+		return info.Packet, ErrPacketNotActive(ibcm.codespace, info.Status)
+	}
+	return info.Packet, nil
+}
+
+// GetEscrow returns the coins of this chain escrowed for the packets sent to a
+// chain, which the vouchers minted by that chain stand for.
+func (ibcm Mapper) GetEscrow(ctx sdk.Context, chainID string) sdk.Coins {
+	store := ctx.KVStore(ibcm.key)
+	bz := store.Get(EscrowKey(chainID))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var escrow sdk.Coins
+	unmarshalBinaryPanic(ibcm.cdc, bz, &escrow)
+	return escrow
+}
+
+// IterateEscrows iterates over the coins escrowed for each chain.
+func (ibcm Mapper) IterateEscrows(ctx sdk.Context, fn func(chainID string, escrow sdk.Coins) (stop bool)) {
+	store := ctx.KVStore(ibcm.key)
+	iterator := sdk.KVStorePrefixIterator(store, EscrowKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow sdk.Coins
+		unmarshalBinaryPanic(ibcm.cdc, iterator.Value(), &escrow)
+		if fn(string(iterator.Key()[len(EscrowKeyPrefix):]), escrow) {
+			break
+		}
+	}
+}
+
+// addEscrow records coins escrowed for the packets sent to a chain.
+func (ibcm Mapper) addEscrow(ctx sdk.Context, chainID string, amt sdk.Coins) {
+	ibcm.setEscrow(ctx, chainID, ibcm.GetEscrow(ctx, chainID).Add(amt))
+}
+
+// subtractEscrow releases escrowed coins returned by a chain. It fails if the
+// coins exceed the ones escrowed for that chain.
+func (ibcm Mapper) subtractEscrow(ctx sdk.Context, chainID string, amt sdk.Coins) sdk.Error {
+	escrow := ibcm.GetEscrow(ctx, chainID)
+	res, hasNeg := escrow.SafeSub(amt)
+	if hasNeg {
+		return ErrInsufficientEscrow(ibcm.codespace, chainID, escrow, amt)
+	}
+
+	ibcm.setEscrow(ctx, chainID, res)
+	return nil
+}
+
+func (ibcm Mapper) setEscrow(ctx sdk.Context, chainID string, escrow sdk.Coins) {
+	store := ctx.KVStore(ibcm.key)
+	if escrow.IsZero() {
+		store.Delete(EscrowKey(chainID))
+		return
+	}
+	store.Set(EscrowKey(chainID), marshalBinaryPanic(ibcm.cdc, escrow))
+}
+
 // Retrieves the index of the currently stored outgoing IBC packets.
 func (ibcm Mapper) getEgressLength(store sdk.KVStore, destChain string) uint64 {
 	bz := store.Get(EgressLengthKey(destChain))
@@ -124,6 +423,38 @@ func EgressLengthKey(destChain string) []byte {
 	return []byte(fmt.Sprintf("egress/%s", destChain))
 }
 
+// Stores the status of an outgoing IBC packet under "status/chain_id/index".
+func PacketStatusKey(destChain string, index uint64) []byte {
+	return []byte(fmt.Sprintf("status/%s/%d", destChain, index))
+}
+
+// Stores the acknowledgement of an incoming IBC packet under
+// "ack/chain_id/index".
+func AckKey(srcChain string, index uint64) []byte {
+	return []byte(fmt.Sprintf("ack/%s/%d", srcChain, index))
+}
+
+// Stores the consensus state of the light client of a chain under
+// "client/chain_id".
+func ClientKey(chainID string) []byte {
+	return []byte(fmt.Sprintf("client/%s", chainID))
+}
+
+// Stores the app hash of a verified header of a chain under
+// "client/chain_id/root/height".
+func ClientRootKey(chainID string, height int64) []byte {
+	return []byte(fmt.Sprintf("client/%s/root/%d", chainID, height))
+}
+
+// EscrowKeyPrefix is the prefix of the keys of the escrowed coins.
+var EscrowKeyPrefix = []byte("escrow/")
+
+// Stores the coins escrowed for the packets sent to a chain under
+// "escrow/chain_id".
+func EscrowKey(chainID string) []byte {
+	return []byte(fmt.Sprintf("escrow/%s", chainID))
+}
+
 // Stores the sequence number of incoming IBC packet under "ingress/index".
 func IngressSequenceKey(srcChain string) []byte {
 	return []byte(fmt.Sprintf("ingress/%s", srcChain))
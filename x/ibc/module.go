@@ -0,0 +1,89 @@
+package ibc
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the IBC module.
+type AppModule struct {
+	mapper     Mapper
+	bankKeeper BankKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(mapper Mapper, bankKeeper BankKeeper) AppModule {
+	return AppModule{
+		mapper:     mapper,
+		bankKeeper: bankKeeper,
+	}
+}
+
+// Name returns the IBC module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the IBC module's types for the given codec
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterInvariants registers the IBC module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	RegisterInvariants(ir, am.mapper, am.bankKeeper)
+}
+
+// Route returns the message routing key for the IBC module
+func (AppModule) Route() string {
+	return RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the IBC module
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.mapper, am.bankKeeper)
+}
+
+// QuerierRoute returns the IBC module's querier route name; packets, clients
+// and acknowledgements are queried from the store with proofs, so IBC has no
+// querier
+func (AppModule) QuerierRoute() string { return "" }
+
+// NewQuerierHandler returns the IBC module sdk.Querier
+func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+
+// DefaultGenesis returns default genesis state as raw bytes for the IBC
+// module; light clients and packet queues are not carried over by genesis
+// files, so IBC has no genesis state
+func (AppModule) DefaultGenesis() json.RawMessage { return nil }
+
+// ValidateGenesis performs genesis state validation for the IBC module
+func (AppModule) ValidateGenesis(_ json.RawMessage) error { return nil }
+
+// InitGenesis performs genesis initialization for the IBC module. It returns
+// no validator updates.
+func (AppModule) InitGenesis(_ sdk.Context, _ json.RawMessage) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the IBC
+// module
+func (AppModule) ExportGenesis(_ sdk.Context) json.RawMessage { return nil }
+
+// BeginBlock returns the begin blocker for the IBC module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the IBC module. It returns no validator
+// updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
@@ -0,0 +1,97 @@
+package ibc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PacketStatus is the status of an outgoing IBC packet on its source chain.
+type PacketStatus byte
+
+// nolint
+const (
+	StatusNil          PacketStatus = 0x00
+	StatusPending      PacketStatus = 0x01
+	StatusAcknowledged PacketStatus = 0x02
+	StatusTimedOut     PacketStatus = 0x03
+)
+
+// PacketStatusFromString turns a string into a PacketStatus
+func PacketStatusFromString(str string) (PacketStatus, error) {
+	switch str {
+	case "Pending":
+		return StatusPending, nil
+	case "Acknowledged":
+		return StatusAcknowledged, nil
+	case "TimedOut":
+		return StatusTimedOut, nil
+	case "":
+		return StatusNil, nil
+	default:
+		return PacketStatus(0xff), fmt.Errorf("'%s' is not a valid packet status", str)
+	}
+}
+
+// Marshals to JSON using string
+func (status PacketStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// Unmarshals from JSON
+func (status *PacketStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := PacketStatusFromString(s)
+	if err != nil {
+		return err
+	}
+	*status = bz2
+	return nil
+}
+
+// Turns PacketStatus byte to String
+func (status PacketStatus) String() string {
+	switch status {
+	case StatusPending:
+		return "Pending"
+	case StatusAcknowledged:
+		return "Acknowledged"
+	case StatusTimedOut:
+		return "TimedOut"
+	default:
+		return ""
+	}
+}
+
+// PacketInfo is an outgoing IBC packet with its sequence and status, as
+// returned by packet queries.
+type PacketInfo struct {
+	Packet   IBCPacket    `json:"packet"`
+	Sequence uint64       `json:"sequence"`
+	Status   PacketStatus `json:"status"`
+}
+
+// NewPacketInfo returns the info of an outgoing IBC packet.
+func NewPacketInfo(packet IBCPacket, sequence uint64, status PacketStatus) PacketInfo {
+	return PacketInfo{
+		Packet:   packet,
+		Sequence: sequence,
+		Status:   status,
+	}
+}
+
+func (pi PacketInfo) String() string {
+	return fmt.Sprintf(`Packet %d to %s:
+  Sender:         %s
+  Recipient:      %s
+  Coins:          %s
+  Timeout Height: %d
+  Status:         %s`,
+		pi.Sequence, pi.Packet.DestChain, pi.Packet.SrcAddr, pi.Packet.DestAddr,
+		pi.Packet.Coins, pi.Packet.TimeoutHeight, pi.Status,
+	)
+}
@@ -0,0 +1,64 @@
+package ibc
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+const (
+	// ProposalTypeCreateClient defines the type for a CreateClientProposal
+	ProposalTypeCreateClient = "CreateClient"
+)
+
+// Assert CreateClientProposal implements gov.ProposalContent at compile-time
+var _ gov.ProposalContent = CreateClientProposal{}
+
+func init() {
+	gov.RegisterProposalTypeCodec(CreateClientProposal{}, "gov/CreateClientProposal")
+}
+
+// CreateClientProposal creates the light client of a counterparty chain
+// trusting its header when it passes. Light clients are only created through
+// governance: a header only has to be signed by its own validator set, so the
+// voters rather than its submitter vouch that it belongs to the chain.
+type CreateClientProposal struct {
+	gov.TextProposal
+	Header Header `json:"header"` // Header of the counterparty chain the client trusts
+}
+
+// NewCreateClientProposal creates a new create client proposal
+func NewCreateClientProposal(title, description string, header Header) CreateClientProposal {
+	return CreateClientProposal{
+		TextProposal: gov.NewTextProposal(title, description),
+		Header:       header,
+	}
+}
+
+// ProposalRoute returns the routing key of a create client proposal
+func (ccp CreateClientProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a create client proposal
+func (ccp CreateClientProposal) ProposalType() string { return ProposalTypeCreateClient }
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp CreateClientProposal) ValidateBasic() sdk.Error {
+	if err := gov.ValidateAbstract(DefaultCodespace, ccp); err != nil {
+		return err
+	}
+	if err := ccp.Header.ValidateBasic(); err != nil {
+		return ErrInvalidHeader(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+func (ccp CreateClientProposal) String() string {
+	return fmt.Sprintf(`Create Client Proposal:
+  Title:       %s
+  Description: %s
+  Chain ID:    %s
+  Height:      %d
+  App Hash:    %X
+`, ccp.Title, ccp.Description, ccp.Header.ChainID(), ccp.Header.Height(), ccp.Header.AppHash())
+}
@@ -0,0 +1,23 @@
+package ibc
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// NewCreateClientProposalHandler returns the gov.Handler creating the light
+// clients of passed create client proposals. The proposal fails if a client of
+// the chain was created during the voting period.
+func NewCreateClientProposalHandler(ibcm Mapper) gov.Handler {
+	return func(ctx sdk.Context, content gov.ProposalContent) sdk.Error {
+		switch c := content.(type) {
+		case CreateClientProposal:
+			return ibcm.CreateClient(ctx, c.Header)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized IBC proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
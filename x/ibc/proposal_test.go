@@ -0,0 +1,56 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func TestCreateClientProposalValidateBasic(t *testing.T) {
+	source := newTestSourceChain(makeCodec(), "source-chain", StoreKey)
+	unsigned := source.header(1, nil)
+	unsigned.SignedHeader.Commit = nil
+
+	tests := []struct {
+		title      string
+		header     Header
+		expectPass bool
+	}{
+		{"Test Proposal", source.header(1, nil), true},
+		{"", source.header(1, nil), false},
+		{"Test Proposal", Header{}, false},
+		{"Test Proposal", unsigned, false},
+	}
+
+	for i, tc := range tests {
+		proposal := NewCreateClientProposal(tc.title, "the purpose of this proposal is to test", tc.header)
+		if tc.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestCreateClientProposalHandler(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	ibcm := NewMapper(input.cdc, input.ibcKey, DefaultCodespace)
+	handler := NewCreateClientProposalHandler(ibcm)
+
+	// the client is created when the proposal passes
+	source := newTestSourceChain(input.cdc, "source-chain", input.ibcKey.Name())
+	require.NoError(t, handler(ctx, NewCreateClientProposal("Test", "description", source.header(1, nil))))
+	cs, found := ibcm.GetConsensusState(ctx, "source-chain")
+	require.True(t, found)
+	require.Equal(t, int64(1), cs.Height)
+
+	// the proposal fails if the client was created during the voting period
+	err := handler(ctx, NewCreateClientProposal("Test", "description", source.header(2, nil)))
+	require.Error(t, err)
+
+	// other proposal contents are not handled
+	require.Error(t, handler(ctx, gov.NewTextProposal("Test", "description")))
+}
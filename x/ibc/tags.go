@@ -0,0 +1,11 @@
+package ibc
+
+// Tag keys and values
+var (
+	TagKeySender    = "sender"
+	TagKeyRecipient = "recipient"
+	TagKeySrcChain  = "src-chain"
+	TagKeyDestChain = "dest-chain"
+	TagKeySequence  = "sequence"
+	TagKeyStatus    = "packet-status"
+)
@@ -3,16 +3,30 @@ package ibc
 import (
 	"encoding/json"
 
+	"github.com/tendermint/tendermint/crypto/merkle"
+
 	codec "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// ModuleName is the name of the IBC module
+	ModuleName = "ibc"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the IBC module
+	RouterKey = ModuleName
+)
+
 var (
 	msgCdc *codec.Codec
 )
 
 func init() {
 	msgCdc = codec.New()
+	codec.RegisterCrypto(msgCdc)
 }
 
 // ------------------------------
@@ -20,27 +34,36 @@ func init() {
 
 // nolint - TODO rename to Packet as IBCPacket stutters (golint)
 // IBCPacket defines a piece of data that can be send between two separate
-// blockchains.
+// blockchains. The destination chain no longer receives it from its timeout
+// height on, so that the sender can be refunded.
 type IBCPacket struct {
-	SrcAddr   sdk.AccAddress `json:"src_addr"`
-	DestAddr  sdk.AccAddress `json:"dest_addr"`
-	Coins     sdk.Coins      `json:"coins"`
-	SrcChain  string         `json:"src_chain"`
-	DestChain string         `json:"dest_chain"`
+	SrcAddr       sdk.AccAddress `json:"src_addr"`
+	DestAddr      sdk.AccAddress `json:"dest_addr"`
+	Coins         sdk.Coins      `json:"coins"`
+	SrcChain      string         `json:"src_chain"`
+	DestChain     string         `json:"dest_chain"`
+	TimeoutHeight uint64         `json:"timeout_height"`
 }
 
 func NewIBCPacket(srcAddr sdk.AccAddress, destAddr sdk.AccAddress, coins sdk.Coins,
-	srcChain string, destChain string) IBCPacket {
+	srcChain string, destChain string, timeoutHeight uint64) IBCPacket {
 
 	return IBCPacket{
-		SrcAddr:   srcAddr,
-		DestAddr:  destAddr,
-		Coins:     coins,
-		SrcChain:  srcChain,
-		DestChain: destChain,
+		SrcAddr:       srcAddr,
+		DestAddr:      destAddr,
+		Coins:         coins,
+		SrcChain:      srcChain,
+		DestChain:     destChain,
+		TimeoutHeight: timeoutHeight,
 	}
 }
 
+// TimedOut returns whether the packet can no longer be received at the given
+// height of the destination chain.
+func (p IBCPacket) TimedOut(height int64) bool {
+	return uint64(height) >= p.TimeoutHeight
+}
+
 //nolint
 func (p IBCPacket) GetSignBytes() []byte {
 	b, err := msgCdc.MarshalJSON(p)
@@ -58,6 +81,12 @@ func (p IBCPacket) ValidateBasic() sdk.Error {
 	if !p.Coins.IsValid() {
 		return sdk.ErrInvalidCoins("")
 	}
+	if p.TimeoutHeight == 0 {
+		return ErrInvalidPacket(DefaultCodespace, "timeout height must be positive")
+	}
+	if !mintedVouchers(p.SrcChain, p.Coins).IsValid() {
+		return ErrInvalidPacket(DefaultCodespace, "source chain ID must be a valid voucher denomination segment")
+	}
 	return nil
 }
 
@@ -71,7 +100,7 @@ type MsgIBCTransfer struct {
 }
 
 // nolint
-func (msg MsgIBCTransfer) Route() string { return "ibc" }
+func (msg MsgIBCTransfer) Route() string { return RouterKey }
 func (msg MsgIBCTransfer) Type() string  { return "transfer" }
 
 // x/bank/tx.go MsgSend.GetSigners()
@@ -92,17 +121,37 @@ func (msg MsgIBCTransfer) ValidateBasic() sdk.Error {
 
 // nolint - TODO rename to ReceiveMsg as folks will reference with ibc.ReceiveMsg
 // MsgIBCReceive defines the message that a relayer uses to post an IBCPacket
-// to the destination chain.
+// to the destination chain, with the proof that the source chain committed it
+// to its egress queue and the header of the source chain committing the proven
+// state.
 type MsgIBCReceive struct {
 	IBCPacket
+	Proof    *merkle.Proof
+	Header   Header
 	Relayer  sdk.AccAddress
 	Sequence uint64
 }
 
 // nolint
-func (msg MsgIBCReceive) Route() string            { return "ibc" }
-func (msg MsgIBCReceive) Type() string             { return "receive" }
-func (msg MsgIBCReceive) ValidateBasic() sdk.Error { return msg.IBCPacket.ValidateBasic() }
+func (msg MsgIBCReceive) Route() string { return RouterKey }
+func (msg MsgIBCReceive) Type() string  { return "receive" }
+
+// validate ibc receive message
+func (msg MsgIBCReceive) ValidateBasic() sdk.Error {
+	if err := msg.IBCPacket.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.Proof == nil {
+		return ErrInvalidProof(DefaultCodespace, "proof must be set")
+	}
+	if err := msg.Header.ValidateBasic(); err != nil {
+		return ErrInvalidHeader(DefaultCodespace, err.Error())
+	}
+	if msg.Header.ChainID() != msg.SrcChain {
+		return ErrInvalidHeader(DefaultCodespace, "header must belong to the source chain")
+	}
+	return nil
+}
 
 // x/bank/tx.go MsgSend.GetSigners()
 func (msg MsgIBCReceive) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Relayer} }
@@ -111,10 +160,14 @@ func (msg MsgIBCReceive) GetSigners() []sdk.AccAddress { return []sdk.AccAddress
 func (msg MsgIBCReceive) GetSignBytes() []byte {
 	b, err := msgCdc.MarshalJSON(struct {
 		IBCPacket json.RawMessage
+		Proof     *merkle.Proof
+		Header    Header
 		Relayer   sdk.AccAddress
 		Sequence  uint64
 	}{
 		IBCPacket: json.RawMessage(msg.IBCPacket.GetSignBytes()),
+		Proof:     msg.Proof,
+		Header:    msg.Header,
 		Relayer:   msg.Relayer,
 		Sequence:  msg.Sequence,
 	})
@@ -123,3 +176,146 @@ func (msg MsgIBCReceive) GetSignBytes() []byte {
 	}
 	return sdk.MustSortJSON(b)
 }
+
+// ----------------------------------
+// MsgUpdateClient
+
+// MsgUpdateClient defines the message that updates the light client of a
+// counterparty chain with a later header.
+type MsgUpdateClient struct {
+	Header Header         `json:"header"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgUpdateClient creates a new MsgUpdateClient instance
+func NewMsgUpdateClient(header Header, signer sdk.AccAddress) MsgUpdateClient {
+	return MsgUpdateClient{
+		Header: header,
+		Signer: signer,
+	}
+}
+
+// nolint
+func (msg MsgUpdateClient) Route() string                { return RouterKey }
+func (msg MsgUpdateClient) Type() string                 { return "update_client" }
+func (msg MsgUpdateClient) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+// get the sign bytes for update client message
+func (msg MsgUpdateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// validate update client message
+func (msg MsgUpdateClient) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+	if err := msg.Header.ValidateBasic(); err != nil {
+		return ErrInvalidHeader(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// ----------------------------------
+// MsgIBCAcknowledgement
+
+// MsgIBCAcknowledgement defines the message that a relayer uses to prove to
+// the source chain of a packet that the destination chain received it, with
+// the proof of the acknowledgement written by the destination chain and the
+// header of the destination chain committing the proven state.
+type MsgIBCAcknowledgement struct {
+	DestChain string         `json:"dest_chain"`
+	Sequence  uint64         `json:"sequence"`
+	Proof     *merkle.Proof  `json:"proof"`
+	Header    Header         `json:"header"`
+	Relayer   sdk.AccAddress `json:"relayer"`
+}
+
+// NewMsgIBCAcknowledgement returns a new acknowledgement message.
+func NewMsgIBCAcknowledgement(destChain string, sequence uint64, proof *merkle.Proof,
+	header Header, relayer sdk.AccAddress) MsgIBCAcknowledgement {
+
+	return MsgIBCAcknowledgement{
+		DestChain: destChain,
+		Sequence:  sequence,
+		Proof:     proof,
+		Header:    header,
+		Relayer:   relayer,
+	}
+}
+
+// nolint
+func (msg MsgIBCAcknowledgement) Route() string                { return RouterKey }
+func (msg MsgIBCAcknowledgement) Type() string                 { return "acknowledgement" }
+func (msg MsgIBCAcknowledgement) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Relayer} }
+
+// get the sign bytes for ibc acknowledgement message
+func (msg MsgIBCAcknowledgement) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// validate ibc acknowledgement message
+func (msg MsgIBCAcknowledgement) ValidateBasic() sdk.Error {
+	return validatePacketProof(msg.DestChain, msg.Proof, msg.Header, msg.Relayer)
+}
+
+// ----------------------------------
+// MsgIBCTimeout
+
+// MsgIBCTimeout defines the message that refunds the sender of a packet the
+// destination chain did not receive before its timeout height, with the proof
+// that the destination chain wrote no acknowledgement of the packet and the
+// header of the destination chain committing the proven state.
+type MsgIBCTimeout struct {
+	DestChain string         `json:"dest_chain"`
+	Sequence  uint64         `json:"sequence"`
+	Proof     *merkle.Proof  `json:"proof"`
+	Header    Header         `json:"header"`
+	Signer    sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgIBCTimeout returns a new timeout message.
+func NewMsgIBCTimeout(destChain string, sequence uint64, proof *merkle.Proof,
+	header Header, signer sdk.AccAddress) MsgIBCTimeout {
+
+	return MsgIBCTimeout{
+		DestChain: destChain,
+		Sequence:  sequence,
+		Proof:     proof,
+		Header:    header,
+		Signer:    signer,
+	}
+}
+
+// nolint
+func (msg MsgIBCTimeout) Route() string                { return RouterKey }
+func (msg MsgIBCTimeout) Type() string                 { return "timeout" }
+func (msg MsgIBCTimeout) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+// get the sign bytes for ibc timeout message
+func (msg MsgIBCTimeout) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// validate ibc timeout message
+func (msg MsgIBCTimeout) ValidateBasic() sdk.Error {
+	return validatePacketProof(msg.DestChain, msg.Proof, msg.Header, msg.Signer)
+}
+
+// validatePacketProof checks the proof about a packet on its destination chain
+// and the header of the destination chain.
+func validatePacketProof(destChain string, proof *merkle.Proof, header Header, signer sdk.AccAddress) sdk.Error {
+	if signer.Empty() {
+		return sdk.ErrInvalidAddress(signer.String())
+	}
+	if proof == nil {
+		return ErrInvalidProof(DefaultCodespace, "proof must be set")
+	}
+	if err := header.ValidateBasic(); err != nil {
+		return ErrInvalidHeader(DefaultCodespace, err.Error())
+	}
+	if header.ChainID() != destChain {
+		return ErrInvalidHeader(DefaultCodespace, "header must belong to the destination chain")
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,6 +20,10 @@ func TestIBCPacketValidation(t *testing.T) {
 	}{
 		{true, constructIBCPacket(true)},
 		{false, constructIBCPacket(false)},
+		{true, NewIBCPacket(sdk.AccAddress([]byte("source")), sdk.AccAddress([]byte("destination")),
+			sdk.Coins{sdk.NewInt64Coin("atom", 10)}, "test-chain-AbC12x", "dest-chain", 100)},
+		{false, NewIBCPacket(sdk.AccAddress([]byte("source")), sdk.AccAddress([]byte("destination")),
+			sdk.Coins{sdk.NewInt64Coin("atom", 10)}, "source chain", "dest-chain", 100)},
 	}
 
 	for i, tc := range cases {
@@ -67,7 +73,7 @@ func TestIBCTransferMsgValidation(t *testing.T) {
 
 func TestIBCReceiveMsg(t *testing.T) {
 	packet := constructIBCPacket(true)
-	msg := MsgIBCReceive{packet, sdk.AccAddress([]byte("relayer")), 0}
+	msg := MsgIBCReceive{packet, &merkle.Proof{}, Header{}, sdk.AccAddress([]byte("relayer")), 0}
 
 	require.Equal(t, msg.Route(), "ibc")
 }
@@ -75,13 +81,22 @@ func TestIBCReceiveMsg(t *testing.T) {
 func TestIBCReceiveMsgValidation(t *testing.T) {
 	validPacket := constructIBCPacket(true)
 	invalidPacket := constructIBCPacket(false)
+	relayer := sdk.AccAddress([]byte("relayer"))
+	proof := &merkle.Proof{}
+	header := newTestSourceChain(makeCodec(), validPacket.SrcChain, "ibc").header(1, nil)
+	otherHeader := newTestSourceChain(makeCodec(), validPacket.DestChain, "ibc").header(1, nil)
+	unsignedHeader := NewHeader(tmtypes.SignedHeader{Header: header.SignedHeader.Header}, header.ValidatorSet, header.NextValidatorSet)
 
 	cases := []struct {
 		valid bool
 		msg   MsgIBCReceive
 	}{
-		{true, MsgIBCReceive{validPacket, sdk.AccAddress([]byte("relayer")), 0}},
-		{false, MsgIBCReceive{invalidPacket, sdk.AccAddress([]byte("relayer")), 0}},
+		{true, MsgIBCReceive{validPacket, proof, header, relayer, 0}},
+		{false, MsgIBCReceive{invalidPacket, proof, header, relayer, 0}},
+		{false, MsgIBCReceive{validPacket, nil, header, relayer, 0}},
+		{false, MsgIBCReceive{validPacket, proof, Header{}, relayer, 0}},
+		{false, MsgIBCReceive{validPacket, proof, otherHeader, relayer, 0}},
+		{false, MsgIBCReceive{validPacket, proof, unsignedHeader, relayer, 0}},
 	}
 
 	for i, tc := range cases {
@@ -94,6 +109,41 @@ func TestIBCReceiveMsgValidation(t *testing.T) {
 	}
 }
 
+// -------------------------------
+// MsgIBCAcknowledgement and MsgIBCTimeout Tests
+
+func TestIBCPacketProofMsgsValidation(t *testing.T) {
+	signer := sdk.AccAddress([]byte("signer"))
+	proof := &merkle.Proof{}
+	header := newTestSourceChain(makeCodec(), "dest-chain", "ibc").header(1, nil)
+
+	cases := []struct {
+		valid     bool
+		destChain string
+		proof     *merkle.Proof
+		header    Header
+		signer    sdk.AccAddress
+	}{
+		{true, "dest-chain", proof, header, signer},
+		{false, "other-chain", proof, header, signer},
+		{false, "dest-chain", nil, header, signer},
+		{false, "dest-chain", proof, Header{}, signer},
+		{false, "dest-chain", proof, header, nil},
+	}
+
+	for i, tc := range cases {
+		ack := MsgIBCAcknowledgement{tc.destChain, 0, tc.proof, tc.header, tc.signer}
+		timeout := MsgIBCTimeout{tc.destChain, 0, tc.proof, tc.header, tc.signer}
+		if tc.valid {
+			require.Nil(t, ack.ValidateBasic(), "%d", i)
+			require.Nil(t, timeout.ValidateBasic(), "%d", i)
+		} else {
+			require.NotNil(t, ack.ValidateBasic(), "%d", i)
+			require.NotNil(t, timeout.ValidateBasic(), "%d", i)
+		}
+	}
+}
+
 // -------------------------------
 // Helpers
 
@@ -105,7 +155,7 @@ func constructIBCPacket(valid bool) IBCPacket {
 	destChain := "dest-chain"
 
 	if valid {
-		return NewIBCPacket(srcAddr, destAddr, coins, srcChain, destChain)
+		return NewIBCPacket(srcAddr, destAddr, coins, srcChain, destChain, 100)
 	}
-	return NewIBCPacket(srcAddr, destAddr, coins, srcChain, srcChain)
+	return NewIBCPacket(srcAddr, destAddr, coins, srcChain, srcChain, 100)
 }
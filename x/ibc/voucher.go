@@ -0,0 +1,64 @@
+package ibc
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoucherPort is the first segment of the denominations of vouchers.
+const VoucherPort = "transfer"
+
+// VoucherPrefix returns the prefix of the denominations of the vouchers minted
+// for coins received from the given chain, "transfer/<chain-id>/".
+func VoucherPrefix(chainID string) string {
+	return fmt.Sprintf("%s/%s/", VoucherPort, chainID)
+}
+
+// VoucherDenom returns the denomination of the vouchers minted for coins of the
+// given denomination received from the given chain.
+func VoucherDenom(chainID, denom string) string {
+	return VoucherPrefix(chainID) + denom
+}
+
+// IsVoucherOf returns whether the denomination is the one of vouchers minted
+// for coins received from the given chain.
+func IsVoucherOf(chainID, denom string) bool {
+	return strings.HasPrefix(denom, VoucherPrefix(chainID))
+}
+
+// splitVouchers splits coins sent to or received from a chain into the
+// vouchers minted for coins of that chain, with their denomination unchanged,
+// and the other coins.
+func splitVouchers(chainID string, coins sdk.Coins) (vouchers, others sdk.Coins) {
+	vouchers, others = sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range coins {
+		if IsVoucherOf(chainID, coin.Denom) {
+			vouchers = append(vouchers, coin)
+		} else {
+			others = append(others, coin)
+		}
+	}
+	return vouchers, others
+}
+
+// mintedVouchers returns the vouchers minted for coins received from the given
+// chain.
+func mintedVouchers(chainID string, coins sdk.Coins) sdk.Coins {
+	vouchers := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		vouchers[i] = sdk.Coin{Denom: VoucherDenom(chainID, coin.Denom), Amount: coin.Amount}
+	}
+	return vouchers.Sort()
+}
+
+// returnedCoins returns the coins that vouchers minted by the given chain for
+// coins of this chain stand for.
+func returnedCoins(chainID string, vouchers sdk.Coins) sdk.Coins {
+	coins := make(sdk.Coins, len(vouchers))
+	for i, voucher := range vouchers {
+		coins[i] = sdk.Coin{Denom: strings.TrimPrefix(voucher.Denom, VoucherPrefix(chainID)), Amount: voucher.Amount}
+	}
+	return coins.Sort()
+}
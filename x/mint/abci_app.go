@@ -2,6 +2,7 @@ package mint
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
 // Inflate every block, update inflation parameters once per hour
@@ -12,15 +13,22 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	params := k.GetParams(ctx)
 
 	// recalculate inflation rate
-	totalSupply := k.sk.TotalTokens(ctx)
+	totalSupply := k.supplyKeeper.TotalSupply(ctx).AmountOf(params.MintDenom)
 	bondedRatio := k.sk.BondedRatio(ctx)
 	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalSupply)
 	k.SetMinter(ctx, minter)
 
-	// mint coins, add to collected fees, update supply
+	// mint coins, send them to the fee collector and add to collected fees
 	mintedCoin := minter.BlockProvision(params)
-	k.fck.AddCollectedFees(ctx, sdk.Coins{mintedCoin})
+	mintedCoins := sdk.NewCoins(mintedCoin)
+	if err := k.bankKeeper.MintCoins(ctx, ModuleName, mintedCoins); err != nil {
+		panic(err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, ModuleName, auth.FeeCollectorName, mintedCoins); err != nil {
+		panic(err)
+	}
+	k.fck.AddCollectedFees(ctx, mintedCoins)
 	k.sk.InflateSupply(ctx, mintedCoin.Amount)
 
 }
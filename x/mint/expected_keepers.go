@@ -6,11 +6,21 @@ import (
 
 // expected staking keeper
 type StakingKeeper interface {
-	TotalTokens(ctx sdk.Context) sdk.Int
 	BondedRatio(ctx sdk.Context) sdk.Dec
 	InflateSupply(ctx sdk.Context, newTokens sdk.Int)
 }
 
+// expected supply keeper
+type SupplyKeeper interface {
+	TotalSupply(ctx sdk.Context) sdk.Coins
+}
+
+// expected bank keeper
+type BankKeeper interface {
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
+}
+
 // expected fee collection keeper interface
 type FeeCollectionKeeper interface {
 	AddCollectedFees(sdk.Context, sdk.Coins) sdk.Coins
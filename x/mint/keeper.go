@@ -8,22 +8,26 @@ import (
 
 // keeper of the staking store
 type Keeper struct {
-	storeKey   sdk.StoreKey
-	cdc        *codec.Codec
-	paramSpace params.Subspace
-	sk         StakingKeeper
-	fck        FeeCollectionKeeper
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   params.Subspace
+	sk           StakingKeeper
+	supplyKeeper SupplyKeeper
+	bankKeeper   BankKeeper
+	fck          FeeCollectionKeeper
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey,
-	paramSpace params.Subspace, sk StakingKeeper, fck FeeCollectionKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	sk StakingKeeper, supplyKeeper SupplyKeeper, bk BankKeeper, fck FeeCollectionKeeper) Keeper {
 
 	keeper := Keeper{
-		storeKey:   key,
-		cdc:        cdc,
-		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
-		sk:         sk,
-		fck:        fck,
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   paramSpace.WithKeyTable(ParamKeyTable()),
+		sk:           sk,
+		supplyKeeper: supplyKeeper,
+		bankKeeper:   bk,
+		fck:          fck,
 	}
 	return keeper
 }
@@ -46,11 +50,14 @@ func ParamKeyTable() params.KeyTable {
 }
 
 const (
+	// ModuleName is the name of the mint module
+	ModuleName = "mint"
+
 	// default paramspace for params keeper
-	DefaultParamspace = "mint"
+	DefaultParamspace = ModuleName
 
 	// StoreKey is the default store key for mint
-	StoreKey = "mint"
+	StoreKey = ModuleName
 )
 
 //______________________________________________________________________
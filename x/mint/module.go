@@ -0,0 +1,97 @@
+package mint
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var _ module.AppModule = AppModule{}
+
+// generic codec used for the mint genesis state
+var moduleCdc = codec.New()
+
+// AppModule implements an application module for the mint module.
+type AppModule struct {
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		keeper: keeper,
+	}
+}
+
+// Name returns the mint module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the mint module's types for the given codec; mint
+// has no types to register
+func (AppModule) RegisterCodec(_ *codec.Codec) {}
+
+// RegisterInvariants registers the mint module invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// Route returns the message routing key for the mint module; mint has no
+// messages
+func (AppModule) Route() string { return "" }
+
+// NewHandler returns an sdk.Handler for the mint module
+func (AppModule) NewHandler() sdk.Handler { return nil }
+
+// QuerierRoute returns the mint module's querier route name; mint has no
+// querier
+func (AppModule) QuerierRoute() string { return "" }
+
+// NewQuerierHandler returns the mint module sdk.Querier
+func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+
+// DefaultGenesis returns default genesis state as raw bytes for the mint
+// module
+func (AppModule) DefaultGenesis() json.RawMessage {
+	return moduleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the mint module
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := moduleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// InitGenesis performs genesis initialization for the mint module. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	moduleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the mint
+// module
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return moduleCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the mint module
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	BeginBlocker(ctx, am.keeper)
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the mint module. It returns no validator
+// updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
@@ -17,8 +17,10 @@ import (
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 const chainID = ""
@@ -32,16 +34,22 @@ type App struct {
 	KeyMain          *sdk.KVStoreKey
 	KeyAccount       *sdk.KVStoreKey
 	KeyFeeCollection *sdk.KVStoreKey
+	KeySupply        *sdk.KVStoreKey
 	KeyParams        *sdk.KVStoreKey
 	TKeyParams       *sdk.TransientStoreKey
 
 	// TODO: Abstract this out from not needing to be auth specifically
 	AccountKeeper       auth.AccountKeeper
 	FeeCollectionKeeper auth.FeeCollectionKeeper
+	SupplyKeeper        supply.Keeper
 	ParamsKeeper        params.Keeper
 
 	GenesisAccounts  []auth.Account
 	TotalCoinsSupply sdk.Coins
+
+	// modules registered through AddModules, run by the module manager
+	modules []module.AppModule
+	mm      *module.Manager
 }
 
 // NewApp partially constructs a new app on the memstore for module and genesis
@@ -60,6 +68,7 @@ func NewApp() *App {
 		KeyMain:          sdk.NewKVStoreKey(bam.MainStoreKey),
 		KeyAccount:       sdk.NewKVStoreKey(auth.StoreKey),
 		KeyFeeCollection: sdk.NewKVStoreKey("fee"),
+		KeySupply:        sdk.NewKVStoreKey(supply.StoreKey),
 		KeyParams:        sdk.NewKVStoreKey("params"),
 		TKeyParams:       sdk.NewTransientStoreKey("transient_params"),
 		TotalCoinsSupply: sdk.NewCoins(),
@@ -78,23 +87,46 @@ func NewApp() *App {
 		app.Cdc,
 		app.KeyFeeCollection,
 	)
+	app.SupplyKeeper = supply.NewKeeper(app.Cdc, app.KeySupply)
+
+	app.modules = []module.AppModule{
+		auth.NewAppModule(app.AccountKeeper, app.FeeCollectionKeeper),
+		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
+	}
 
 	// Initialize the app. The chainers and blockers can be overwritten before
 	// calling complete setup.
 	app.SetInitChainer(app.InitChainer)
-	app.SetAnteHandler(auth.NewAnteHandler(app.AccountKeeper, app.FeeCollectionKeeper))
+	app.SetBeginBlocker(app.BeginBlocker)
+	app.SetEndBlocker(app.EndBlocker)
+	app.SetAnteHandler(auth.NewAnteHandler(app.AccountKeeper, app.FeeCollectionKeeper, app.SupplyKeeper))
 
 	// Not sealing for custom extension
 
 	return app
 }
 
+// AddModules registers the given modules with the app and their types with
+// the app's codec. Their routes are registered when completing the setup, and
+// their genesis and block functions are run in the order they were added,
+// unless the chainers and blockers are overwritten.
+func (app *App) AddModules(modules ...module.AppModule) {
+	for _, m := range modules {
+		m.RegisterCodec(app.Cdc)
+	}
+	app.modules = append(app.modules, modules...)
+}
+
 // CompleteSetup completes the application setup after the routes have been
 // registered.
 func (app *App) CompleteSetup(newKeys ...sdk.StoreKey) error {
+	app.mm = module.NewManager(app.modules...)
+	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+
 	newKeys = append(
 		newKeys,
 		app.KeyMain, app.KeyAccount, app.KeyParams, app.TKeyParams, app.KeyFeeCollection,
+		app.KeySupply,
 	)
 
 	for _, key := range newKeys {
@@ -123,9 +155,17 @@ func (app *App) InitChainer(ctx sdk.Context, _ abci.RequestInitChain) abci.Respo
 		app.AccountKeeper.SetAccount(ctx, acc)
 	}
 
-	auth.InitGenesis(ctx, app.AccountKeeper, app.FeeCollectionKeeper, auth.DefaultGenesisState())
+	return app.mm.InitGenesis(ctx, app.mm.DefaultGenesis())
+}
+
+// BeginBlocker runs the begin blockers of the registered modules.
+func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	return app.mm.BeginBlock(ctx, req)
+}
 
-	return abci.ResponseInitChain{}
+// EndBlocker runs the end blockers of the registered modules.
+func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	return app.mm.EndBlock(ctx, req)
 }
 
 // Type that combines an Address with the privKey and pubKey to that address
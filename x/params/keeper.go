@@ -13,6 +13,9 @@ const (
 
 	// TStoreKey is the string key for the params transient store
 	TStoreKey = subspace.TStoreKey
+
+	// RouterKey is the route of parameter change proposals
+	RouterKey = "params"
 )
 
 // Keeper of the global paramstore
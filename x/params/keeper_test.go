@@ -216,3 +216,54 @@ func TestSubspace(t *testing.T) {
 		require.Equal(t, kv.param, indirect(kv.ptr), "stored param not equal, tc #%d", i)
 	}
 }
+
+func TestSubspaceUpdate(t *testing.T) {
+	cdc := createTestCodec()
+	key := sdk.NewKVStoreKey("test")
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	ctx := defaultContext(key, tkey)
+	keeper := NewKeeper(cdc, key, tkey)
+
+	table := NewKeyTable(
+		[]byte("int64"), int64(0),
+		[]byte("dec"), sdk.Dec{},
+		[]byte("struct"), s{},
+		[]byte("schedule"), sdk.GasSchedule{},
+	)
+	space := keeper.Subspace("test").WithKeyTable(table)
+
+	// invalid updates fail without modifying the parameter
+	require.Error(t, space.Update(ctx, []byte("invalid"), []byte(`"1"`)))
+	require.Error(t, space.Update(ctx, []byte("int64"), []byte(`"a"`)))
+	require.Error(t, space.Update(ctx, []byte("struct"), []byte(`true`)))
+	require.False(t, space.Modified(ctx, []byte("int64")))
+	require.False(t, space.Modified(ctx, []byte("struct")))
+
+	require.NoError(t, space.Validate([]byte("int64"), []byte(`"10"`)))
+	require.Error(t, space.Validate([]byte("int64"), []byte(`true`)))
+
+	var i int64
+	require.NoError(t, space.Update(ctx, []byte("int64"), []byte(`"10"`)))
+	space.Get(ctx, []byte("int64"), &i)
+	require.Equal(t, int64(10), i)
+
+	var d sdk.Dec
+	require.NoError(t, space.Update(ctx, []byte("dec"), []byte(`"0.5"`)))
+	space.Get(ctx, []byte("dec"), &d)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), d)
+
+	var st s
+	require.NoError(t, space.Update(ctx, []byte("struct"), []byte(`{"type": "test/s", "value": {"I": "3"}}`)))
+	space.Get(ctx, []byte("struct"), &st)
+	require.Equal(t, s{3}, st)
+	require.True(t, space.Modified(ctx, []byte("struct")))
+
+	// values of types with validation are validated
+	require.Error(t, space.Update(ctx, []byte("schedule"), []byte(`{}`)))
+	require.False(t, space.Modified(ctx, []byte("schedule")))
+
+	var gs sdk.GasSchedule
+	require.NoError(t, space.Update(ctx, []byte("schedule"), cdc.MustMarshalJSON(sdk.DefaultGasSchedule())))
+	space.Get(ctx, []byte("schedule"), &gs)
+	require.Equal(t, sdk.DefaultGasSchedule(), gs)
+}
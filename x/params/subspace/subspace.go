@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -176,6 +177,54 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 
 }
 
+// Validate checks that the parameter is registered and that value is a JSON
+// encoded parameter of the registered type.
+func (s Subspace) Validate(key []byte, value []byte) error {
+	_, err := s.decode(key, value)
+	return err
+}
+
+// Update sets the parameter from its JSON encoded value, as e.g. given by a
+// parameter change proposal. It returns error instead of panicking if the
+// parameter is not registered or value is not of the registered type.
+func (s Subspace) Update(ctx sdk.Context, key []byte, value []byte) error {
+	param, err := s.decode(key, value)
+	if err != nil {
+		return err
+	}
+
+	s.Set(ctx, key, param)
+	return nil
+}
+
+// decodes a JSON encoded parameter into a value of its registered type
+func (s Subspace) decode(key []byte, value []byte) (interface{}, error) {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return nil, fmt.Errorf("parameter %s not registered in subspace %s", key, s.name)
+	}
+
+	ptr := reflect.New(attr.ty)
+	if err := s.cdc.UnmarshalJSON(value, ptr.Interface()); err != nil {
+		return nil, fmt.Errorf("invalid value for parameter %s: %s", key, err)
+	}
+
+	param := ptr.Elem().Interface()
+	if v, ok := param.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid value for parameter %s: %s", key, err)
+		}
+	}
+
+	return param, nil
+}
+
+// validator is implemented by parameter types that restrict their valid
+// values, which are checked before a parameter is updated
+type validator interface {
+	Validate() error
+}
+
 // SetWithSubkey set a parameter with a key and subkey
 // Checks parameter type only over the key
 func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
@@ -9,4 +9,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnjail{}, "cosmos-sdk/MsgUnjail", nil)
 }
 
-var cdcEmpty = codec.New()
+// generic codec used by the slashing module
+var moduleCdc = codec.New()
+
+func init() {
+	RegisterCodec(moduleCdc)
+	codec.RegisterCrypto(moduleCdc)
+}
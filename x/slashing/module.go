@@ -0,0 +1,110 @@
+package slashing
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the slashing module.
+type AppModule struct {
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		keeper: keeper,
+	}
+}
+
+// Name returns the slashing module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the slashing module's types for the given codec
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterInvariants registers the slashing module invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// Route returns the message routing key for the slashing module
+func (AppModule) Route() string {
+	return RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the slashing module
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// QuerierRoute returns the slashing module's querier route name
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// NewQuerierHandler returns the slashing module sdk.Querier
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper, moduleCdc)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the slashing
+// module
+func (AppModule) DefaultGenesis() json.RawMessage {
+	return moduleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the slashing module
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := moduleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// InitGenesis performs genesis initialization for the slashing module. The
+// staking genesis must have been initialized beforehand, as the validator
+// pubkeys are taken from the validator set. It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	moduleCdc.MustUnmarshalJSON(data, &genesisState)
+
+	var validators []sdk.Validator
+	am.keeper.validatorSet.IterateValidators(ctx,
+		func(_ int64, validator sdk.Validator) (stop bool) {
+			validators = append(validators, validator)
+			return false
+		},
+	)
+
+	InitGenesis(ctx, am.keeper, genesisState, validators)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the
+// slashing module
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return moduleCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the slashing module
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) sdk.Tags {
+	return BeginBlocker(ctx, req, am.keeper)
+}
+
+// EndBlock returns the end blocker for the slashing module. It returns no
+// validator updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
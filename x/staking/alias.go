@@ -10,9 +10,7 @@ import (
 
 type (
 	Keeper                  = keeper.Keeper
-	FeeCollectionKeeper     = types.FeeCollectionKeeper
 	BankKeeper              = types.BankKeeper
-	DistributionKeeper      = types.DistributionKeeper
 	Validator               = types.Validator
 	Validators              = types.Validators
 	Description             = types.Description
@@ -95,6 +93,7 @@ var (
 	NewQueryDelegatorParams = querier.NewQueryDelegatorParams
 	NewQueryValidatorParams = querier.NewQueryValidatorParams
 	NewQueryBondsParams     = querier.NewQueryBondsParams
+	ProvableQueries         = querier.ProvableQueries
 )
 
 const (
@@ -115,6 +114,7 @@ const (
 )
 
 const (
+	ModuleName            = types.ModuleName
 	StoreKey              = types.StoreKey
 	TStoreKey             = types.TStoreKey
 	QuerierRoute          = types.QuerierRoute
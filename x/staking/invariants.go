@@ -1,4 +1,4 @@
-package simulation
+package staking
 
 import (
 	"bytes"
@@ -6,18 +6,29 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
 
-// AllInvariants runs all invariants of the staking module.
-// Currently: total supply, positive power
-func AllInvariants(k staking.Keeper,
-	f staking.FeeCollectionKeeper, d staking.DistributionKeeper,
-	am auth.AccountKeeper) sdk.Invariant {
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRouter, k Keeper, am auth.AccountKeeper) {
+	ir.RegisterRoute(ModuleName, "supply",
+		SupplyInvariants(k, am))
+	ir.RegisterRoute(ModuleName, "module-account",
+		ModuleAccountInvariant(k, am))
+	ir.RegisterRoute(ModuleName, "nonnegative-power",
+		NonNegativePowerInvariant(k))
+}
 
+// AllInvariants runs all invariants of the staking module.
+// Currently: total supply, module account, positive power
+func AllInvariants(k Keeper, am auth.AccountKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
-		err := SupplyInvariants(k, f, d, am)(ctx)
+		err := SupplyInvariants(k, am)(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = ModuleAccountInvariant(k, am)(ctx)
 		if err != nil {
 			return err
 		}
@@ -42,52 +53,47 @@ func AllInvariants(k staking.Keeper,
 }
 
 // SupplyInvariants checks that the total supply reflects all held not-bonded tokens, bonded tokens, and unbonding delegations
-// nolint: unparam
-func SupplyInvariants(k staking.Keeper,
-	f staking.FeeCollectionKeeper, d staking.DistributionKeeper, am auth.AccountKeeper) sdk.Invariant {
+func SupplyInvariants(k Keeper, am auth.AccountKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 		pool := k.GetPool(ctx)
+		moduleAddr := auth.NewModuleAddress(ModuleName)
 
-		loose := sdk.ZeroDec()
-		bonded := sdk.ZeroDec()
+		// the tokens held by the staking module account are accounted for
+		// through the validators and unbonding delegations below
+		loose := sdk.ZeroInt()
+		bonded := sdk.ZeroInt()
 		am.IterateAccounts(ctx, func(acc auth.Account) bool {
-			loose = loose.Add(acc.GetCoins().AmountOf(k.BondDenom(ctx)).ToDec())
+			if !acc.GetAddress().Equals(moduleAddr) {
+				loose = loose.Add(acc.GetCoins().AmountOf(k.BondDenom(ctx)))
+			}
 			return false
 		})
-		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd staking.UnbondingDelegation) bool {
+		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd UnbondingDelegation) bool {
 			for _, entry := range ubd.Entries {
-				loose = loose.Add(entry.Balance.ToDec())
+				loose = loose.Add(entry.Balance)
 			}
 			return false
 		})
 		k.IterateValidators(ctx, func(_ int64, validator sdk.Validator) bool {
 			switch validator.GetStatus() {
 			case sdk.Bonded:
-				bonded = bonded.Add(validator.GetBondedTokens().ToDec())
+				bonded = bonded.Add(validator.GetBondedTokens())
 			case sdk.Unbonding, sdk.Unbonded:
-				loose = loose.Add(validator.GetTokens().ToDec())
+				loose = loose.Add(validator.GetTokens())
 			}
-			// add yet-to-be-withdrawn
-			loose = loose.Add(d.GetValidatorOutstandingRewardsCoins(ctx, validator.GetOperator()).AmountOf(k.BondDenom(ctx)))
 			return false
 		})
 
-		// add outstanding fees
-		loose = loose.Add(f.GetCollectedFees(ctx).AmountOf(k.BondDenom(ctx)).ToDec())
-
-		// add community pool
-		loose = loose.Add(d.GetFeePoolCommunityCoins(ctx).AmountOf(k.BondDenom(ctx)))
-
 		// Not-bonded tokens should equal coin supply plus unbonding delegations
 		// plus tokens on unbonded validators
-		if !pool.NotBondedTokens.ToDec().Equal(loose) {
+		if !pool.NotBondedTokens.Equal(loose) {
 			return fmt.Errorf("loose token invariance:\n"+
 				"\tpool.NotBondedTokens: %v\n"+
 				"\tsum of account tokens: %v", pool.NotBondedTokens, loose)
 		}
 
 		// Bonded tokens should equal sum of tokens with bonded validators
-		if !pool.BondedTokens.ToDec().Equal(bonded) {
+		if !pool.BondedTokens.Equal(bonded) {
 			return fmt.Errorf("bonded token invariance:\n"+
 				"\tpool.BondedTokens: %v\n"+
 				"\tsum of account tokens: %v", pool.BondedTokens, bonded)
@@ -97,8 +103,39 @@ func SupplyInvariants(k staking.Keeper,
 	}
 }
 
+// ModuleAccountInvariant checks that the staking module account holds the
+// tokens of all validators and unbonding delegations.
+func ModuleAccountInvariant(k Keeper, am auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		expected := sdk.ZeroInt()
+		k.IterateValidators(ctx, func(_ int64, validator sdk.Validator) bool {
+			expected = expected.Add(validator.GetTokens())
+			return false
+		})
+		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd UnbondingDelegation) bool {
+			for _, entry := range ubd.Entries {
+				expected = expected.Add(entry.Balance)
+			}
+			return false
+		})
+
+		held := sdk.ZeroInt()
+		if acc := am.GetAccount(ctx, auth.NewModuleAddress(ModuleName)); acc != nil {
+			held = acc.GetCoins().AmountOf(k.BondDenom(ctx))
+		}
+
+		if !held.Equal(expected) {
+			return fmt.Errorf("module account invariance:\n"+
+				"\tstaking module account tokens: %v\n"+
+				"\tsum of validator and unbonding tokens: %v", held, expected)
+		}
+
+		return nil
+	}
+}
+
 // NonNegativePowerInvariant checks that all stored validators have >= 0 power.
-func NonNegativePowerInvariant(k staking.Keeper) sdk.Invariant {
+func NonNegativePowerInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 		iterator := k.ValidatorsPowerStoreIterator(ctx)
 
@@ -126,7 +163,7 @@ func NonNegativePowerInvariant(k staking.Keeper) sdk.Invariant {
 }
 
 // PositiveDelegationInvariant checks that all stored delegations have > 0 shares.
-func PositiveDelegationInvariant(k staking.Keeper) sdk.Invariant {
+func PositiveDelegationInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 		delegations := k.GetAllDelegations(ctx)
 		for _, delegation := range delegations {
@@ -145,7 +182,7 @@ func PositiveDelegationInvariant(k staking.Keeper) sdk.Invariant {
 // DelegatorSharesInvariant checks whether all the delegator shares which persist
 // in the delegator object add up to the correct total delegator shares
 // amount stored in each validator
-func DelegatorSharesInvariant(k staking.Keeper) sdk.Invariant {
+func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 		validators := k.GetAllValidators(ctx)
 		for _, validator := range validators {
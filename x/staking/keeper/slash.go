@@ -123,6 +123,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	// Burn the slashed tokens, which are now loose.
 	pool.NotBondedTokens = pool.NotBondedTokens.Sub(tokensToBurn)
 	k.SetPool(ctx, pool)
+	k.burnTokens(ctx, tokensToBurn)
 
 	// Log that a slash occurred!
 	logger.Info(fmt.Sprintf(
@@ -201,6 +202,7 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		// Ref https://github.com/cosmos/cosmos-sdk/pull/1278#discussion_r198657760
 		pool.NotBondedTokens = pool.NotBondedTokens.Sub(unbondingSlashAmount)
 		k.SetPool(ctx, pool)
+		k.burnTokens(ctx, unbondingSlashAmount)
 	}
 
 	return totalSlashAmount
@@ -259,7 +261,16 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		pool := k.GetPool(ctx)
 		pool.NotBondedTokens = pool.NotBondedTokens.Sub(tokensToBurn)
 		k.SetPool(ctx, pool)
+		k.burnTokens(ctx, tokensToBurn)
 	}
 
 	return totalSlashAmount
 }
+
+// burn slashed tokens from the staking module account
+func (k Keeper) burnTokens(ctx sdk.Context, amt sdk.Int) {
+	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt)))
+	if err != nil {
+		panic(fmt.Errorf("error burning slashed tokens: %v", err))
+	}
+}
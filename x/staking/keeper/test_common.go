@@ -43,6 +43,9 @@ var (
 		sdk.ValAddress(Addrs[5]),
 		sdk.ValAddress(Addrs[6]),
 	}
+
+	// tokens minted to the staking module account for the tests
+	ModuleAccountTokens = sdk.TokensFromTendermintPower(1000000)
 )
 
 //_______________________________________________________________________________________
@@ -69,6 +72,7 @@ func MakeTestCodec() *codec.Codec {
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/staking/Account", nil)
+	cdc.RegisterConcrete(&auth.ModuleAccount{}, "test/staking/ModuleAccount", nil)
 	codec.RegisterCrypto(cdc)
 
 	return cdc
@@ -116,10 +120,14 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 		auth.ProtoBaseAccount, // prototype
 	)
 
+	// the tests create validators and delegations without moving coins from
+	// accounts, so the supply is not tracked
 	ck := bank.NewBaseKeeper(
 		accountKeeper,
+		DummySupplyKeeper{},
 		pk.Subspace(bank.DefaultParamspace),
 		bank.DefaultCodespace,
+		map[string][]string{types.ModuleName: {auth.Minter, auth.Burner, auth.Staking}},
 	)
 
 	keeper := NewKeeper(cdc, keyStaking, tkeyStaking, ck, pk.Subspace(DefaultParamspace), types.DefaultCodespace)
@@ -140,9 +148,26 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 		keeper.SetPool(ctx, pool)
 	}
 
+	// the staking module account holds the tokens of the validators the tests
+	// create without delegating coins
+	err = ck.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), ModuleAccountTokens)))
+	require.Nil(t, err)
+
 	return ctx, accountKeeper, keeper
 }
 
+//__________________________________________________________________________________
+// supply keeper used only for testing
+type DummySupplyKeeper struct{}
+
+var _ bank.SupplyKeeper = DummySupplyKeeper{}
+
+// nolint
+func (DummySupplyKeeper) InflateLiquid(_ sdk.Context, _ sdk.Coins)  {}
+func (DummySupplyKeeper) DeflateLiquid(_ sdk.Context, _ sdk.Coins)  {}
+func (DummySupplyKeeper) InflateModules(_ sdk.Context, _ sdk.Coins) {}
+func (DummySupplyKeeper) DeflateModules(_ sdk.Context, _ sdk.Coins) {}
+
 func NewPubKey(pk string) (res crypto.PubKey) {
 	pkBytes, err := hex.DecodeString(pk)
 	if err != nil {
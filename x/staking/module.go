@@ -0,0 +1,109 @@
+package staking
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the staking module.
+type AppModule struct {
+	keeper        Keeper
+	accountKeeper auth.AccountKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper, accountKeeper auth.AccountKeeper) AppModule {
+	return AppModule{
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+	}
+}
+
+// Name returns the staking module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the staking module's types for the given codec
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterInvariants registers the staking module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	RegisterInvariants(ir, am.keeper, am.accountKeeper)
+}
+
+// Route returns the message routing key for the staking module
+func (AppModule) Route() string {
+	return RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the staking module
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// QuerierRoute returns the staking module's querier route name
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// NewQuerierHandler returns the staking module sdk.Querier
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper, types.MsgCdc)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the staking
+// module
+func (AppModule) DefaultGenesis() json.RawMessage {
+	return types.MsgCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the staking module
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := types.MsgCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// InitGenesis performs genesis initialization for the staking module. It
+// returns the initial validator set.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	types.MsgCdc.MustUnmarshalJSON(data, &genesisState)
+	validators, err := InitGenesis(ctx, am.keeper, genesisState)
+	if err != nil {
+		panic(err) // TODO find a way to do this w/o panics
+	}
+	return validators
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the
+// staking module
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return types.MsgCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the staking module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the staking module. It returns the
+// validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return EndBlocker(ctx, am.keeper)
+}
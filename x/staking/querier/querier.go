@@ -354,3 +354,60 @@ func queryParameters(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []by
 	}
 	return res, nil
 }
+
+//______________________________________________________________________________
+
+// ProvableQueries returns the store keys backing the staking queries of a
+// single validator, delegation or unbonding delegation by query path, see
+// sdk.ProvableQuery.
+func ProvableQueries(cdc *codec.Codec) map[string]sdk.ProvableQuery {
+	validatorKey := func(data []byte) ([]byte, error) {
+		var params QueryValidatorParams
+		if err := cdc.UnmarshalJSON(data, &params); err != nil {
+			return nil, err
+		}
+		return keep.GetValidatorKey(params.ValidatorAddr), nil
+	}
+	bondsKey := func(key func(sdk.AccAddress, sdk.ValAddress) []byte) func([]byte) ([]byte, error) {
+		return func(data []byte) ([]byte, error) {
+			var params QueryBondsParams
+			if err := cdc.UnmarshalJSON(data, &params); err != nil {
+				return nil, err
+			}
+			return key(params.DelegatorAddr, params.ValidatorAddr), nil
+		}
+	}
+
+	return map[string]sdk.ProvableQuery{
+		fmt.Sprintf("custom/%s/%s", types.QuerierRoute, QueryValidator): sdk.NewSingleKeyProvableQuery(
+			cdc, types.StoreKey, validatorKey, decodedValue(types.ErrNoValidatorFound(types.DefaultCodespace),
+				func(value []byte) (interface{}, error) { return types.UnmarshalValidator(cdc, value) }),
+		),
+		fmt.Sprintf("custom/%s/%s", types.QuerierRoute, QueryDelegation): sdk.NewSingleKeyProvableQuery(
+			cdc, types.StoreKey, bondsKey(keep.GetDelegationKey), decodedValue(types.ErrNoDelegation(types.DefaultCodespace),
+				func(value []byte) (interface{}, error) { return types.UnmarshalDelegation(cdc, value) }),
+		),
+		fmt.Sprintf("custom/%s/%s", types.QuerierRoute, QueryUnbondingDelegation): sdk.NewSingleKeyProvableQuery(
+			cdc, types.StoreKey, bondsKey(keep.GetUBDKey), decodedValue(types.ErrNoUnbondingDelegation(types.DefaultCodespace),
+				func(value []byte) (interface{}, error) { return types.UnmarshalUBD(cdc, value) }),
+		),
+	}
+}
+
+// decodedValue builds the result of a single key query from the value decoded
+// by decode, or errNotFound if the key is missing.
+func decodedValue(
+	errNotFound sdk.Error, decode func(value []byte) (interface{}, error),
+) func(data, value []byte) (interface{}, sdk.Error) {
+	return func(_, value []byte) (interface{}, sdk.Error) {
+		if len(value) == 0 {
+			return nil, errNotFound
+		}
+
+		res, err := decode(value)
+		if err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to decode value: %s", err))
+		}
+		return res, nil
+	}
+}
@@ -433,3 +433,58 @@ func TestQueryRedelegations(t *testing.T) {
 
 	require.Equal(t, redelegation, redsRes[0])
 }
+
+func TestProvableQueries(t *testing.T) {
+	cdc := keep.MakeTestCodec()
+	ctx, _, keeper := keep.CreateTestInput(t, false, 10000)
+	queries := ProvableQueries(cdc)
+
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidatorByPowerIndex(ctx, val1)
+	keeper.Delegate(ctx, addrAcc2, sdk.TokensFromTendermintPower(20), val1, true)
+	val1, _ = keeper.GetValidator(ctx, addrVal1)
+	delegation, _ := keeper.GetDelegation(ctx, addrAcc2, addrVal1)
+
+	tests := []struct {
+		path  string
+		data  interface{}
+		key   []byte
+		value []byte
+	}{
+		{
+			"custom/staking/validator", NewQueryValidatorParams(addrVal1),
+			keep.GetValidatorKey(addrVal1), types.MustMarshalValidator(cdc, val1),
+		},
+		{
+			"custom/staking/delegation", NewQueryBondsParams(addrAcc2, addrVal1),
+			keep.GetDelegationKey(addrAcc2, addrVal1), types.MustMarshalDelegation(cdc, delegation),
+		},
+		{
+			"custom/staking/unbondingDelegation", NewQueryBondsParams(addrAcc2, addrVal1),
+			keep.GetUBDKey(addrAcc2, addrVal1), nil,
+		},
+	}
+
+	for _, tc := range tests {
+		query, ok := queries[tc.path]
+		require.True(t, ok, tc.path)
+		require.Equal(t, types.StoreKey, query.Store)
+
+		req := abci.RequestQuery{Path: tc.path, Data: cdc.MustMarshalJSON(tc.data)}
+		keys, err := query.Keys(req.Data)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{tc.key}, keys, tc.path)
+
+		// the result rebuilt from the value of the key matches the querier's
+		querier := NewQuerier(keeper, cdc)
+		expRes, expErr := querier(ctx, []string{tc.path[len("custom/staking/"):]}, req)
+		res, sdkErr := query.Result(req.Data, [][]byte{tc.value})
+		require.Equal(t, expErr == nil, sdkErr == nil, tc.path)
+		if expErr != nil {
+			require.Equal(t, expErr.Code(), sdkErr.Code(), tc.path)
+			continue
+		}
+		require.Equal(t, string(expRes), string(res), tc.path)
+	}
+}
@@ -2,19 +2,9 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-// expected coin keeper
-type DistributionKeeper interface {
-	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins
-	GetValidatorOutstandingRewardsCoins(ctx sdk.Context, val sdk.ValAddress) sdk.DecCoins
-}
-
-// expected fee collection keeper
-type FeeCollectionKeeper interface {
-	GetCollectedFees(ctx sdk.Context) sdk.Coins
-}
-
 // expected bank keeper
 type BankKeeper interface {
-	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
-	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 }
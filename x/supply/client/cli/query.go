@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// GetCmdQueryTotalSupply implements the command to query the supply of every
+// denomination, or of a single one.
+func GetCmdQueryTotalSupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "total [denom]",
+		Short: "Query the total supply of coins of the chain",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(`Query the liquid, module held and total supply of every denomination:
+
+$ gaiacli query supply total
+
+To query the supply of a single denomination:
+
+$ gaiacli query supply total stake
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				route := fmt.Sprintf("custom/%s/%s", queryRoute, supply.QuerySupply)
+				res, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+
+				var totalSupply supply.Supply
+				cdc.MustUnmarshalJSON(res, &totalSupply)
+				return cliCtx.PrintOutput(totalSupply)
+			}
+
+			bz, err := cdc.MarshalJSON(supply.NewQuerySupplyOfParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, supply.QuerySupplyOf)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var supplyOf supply.SupplyOf
+			cdc.MustUnmarshalJSON(res, &supplyOf)
+			return cliCtx.PrintOutput(supplyOf)
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/supply/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	// Group supply queries under a subcommand
+	supplyQueryCmd := &cobra.Command{
+		Use:   supply.ModuleName,
+		Short: "Querying commands for the supply module",
+	}
+
+	supplyQueryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryTotalSupply(mc.storeKey, mc.cdc),
+	)...)
+
+	return supplyQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module; supply has no
+// transactions
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   supply.ModuleName,
+		Short: "Supply transaction subcommands",
+	}
+}
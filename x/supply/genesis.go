@@ -0,0 +1,42 @@
+package supply
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// GenesisState is the supply state that must be provided at genesis.
+type GenesisState struct {
+	Supply Supply `json:"supply"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(supply Supply) GenesisState {
+	return GenesisState{Supply: supply}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState { return NewGenesisState(DefaultSupply()) }
+
+// InitGenesis sets supply information for genesis. If the genesis supply is
+// empty, it is computed from the balances of the genesis accounts, which must
+// therefore be set before.
+func InitGenesis(ctx sdk.Context, keeper Keeper, ak auth.AccountKeeper, data GenesisState) {
+	supply := data.Supply
+	if supply.Total.IsZero() {
+		supply = NewSupply(accountBalances(ctx, ak))
+	}
+
+	keeper.SetSupply(ctx, supply)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(keeper.GetSupply(ctx))
+}
+
+// ValidateGenesis performs basic validation of supply genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return data.Supply.ValidateBasic()
+}
@@ -0,0 +1,66 @@
+package supply
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// register all supply invariants
+func RegisterInvariants(ir sdk.InvariantRouter, k Keeper, ak auth.AccountKeeper) {
+	ir.RegisterRoute(ModuleName, "total-supply",
+		TotalSupplyInvariant(k, ak))
+}
+
+// TotalSupplyInvariant checks that the liquid and module held supply equal the
+// sum of the balances of the accounts and of the module accounts respectively,
+// and that the total supply is the sum of both.
+func TotalSupplyInvariant(k Keeper, ak auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		supply := k.GetSupply(ctx)
+
+		liquid, modules := accountBalances(ctx, ak)
+		if !coinsEqual(liquid, supply.Liquid) {
+			return fmt.Errorf("total supply invariance:\n"+
+				"\tsum of account balances: %v\n"+
+				"\tsupply.Liquid: %v", liquid, supply.Liquid)
+		}
+
+		if !coinsEqual(modules, supply.Modules) {
+			return fmt.Errorf("total supply invariance:\n"+
+				"\tsum of module account balances: %v\n"+
+				"\tsupply.Modules: %v", modules, supply.Modules)
+		}
+
+		if !coinsEqual(supply.Total, supply.Liquid.Add(supply.Modules)) {
+			return fmt.Errorf("total supply invariance:\n"+
+				"\tsupply.Total: %v\n"+
+				"\tsupply.Liquid + supply.Modules: %v", supply.Total, supply.Liquid.Add(supply.Modules))
+		}
+
+		return nil
+	}
+}
+
+// accountBalances returns the sum of the balances of the accounts and of the
+// module accounts.
+func accountBalances(ctx sdk.Context, ak auth.AccountKeeper) (liquid, modules sdk.Coins) {
+	liquid, modules = sdk.NewCoins(), sdk.NewCoins()
+	ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+		if _, ok := acc.(*auth.ModuleAccount); ok {
+			modules = modules.Add(acc.GetCoins())
+		} else {
+			liquid = liquid.Add(acc.GetCoins())
+		}
+		return false
+	})
+	return liquid, modules
+}
+
+// coinsEqual returns whether two sets of coins are equal. Unlike Coins.IsEqual,
+// it does not panic on sets of the same length with different denominations.
+func coinsEqual(a, b sdk.Coins) bool {
+	diff, hasNeg := a.SafeSub(b)
+	return !hasNeg && diff.IsZero()
+}
@@ -0,0 +1,116 @@
+package supply
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the supply module
+	ModuleName = "supply"
+
+	// StoreKey is the store key string for supply
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for supply
+	QuerierRoute = ModuleName
+)
+
+// Keys for supply store
+var (
+	SupplyKey = []byte{0x00} // key for the supply
+)
+
+// Keeper of the supply store. The keeper only records the supply; the modules
+// that create, destroy or move coins between accounts and their own pools
+// are responsible for reporting the changes to it.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+}
+
+// NewKeeper creates a new supply Keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+	}
+}
+
+// GetSupply returns the supply, which is empty if it has never been set
+func (k Keeper) GetSupply(ctx sdk.Context) (supply Supply) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(SupplyKey)
+	if bz == nil {
+		return DefaultSupply()
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &supply)
+	return
+}
+
+// SetSupply sets the supply
+func (k Keeper) SetSupply(ctx sdk.Context, supply Supply) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(SupplyKey, k.cdc.MustMarshalBinaryLengthPrefixed(supply))
+}
+
+// TotalSupply returns the total supply of every denomination
+func (k Keeper) TotalSupply(ctx sdk.Context) sdk.Coins {
+	return k.GetSupply(ctx).Total
+}
+
+// InflateLiquid records coins added to accounts
+func (k Keeper) InflateLiquid(ctx sdk.Context, amt sdk.Coins) {
+	if amt.IsZero() {
+		return
+	}
+	supply := k.GetSupply(ctx)
+	supply.Liquid = supply.Liquid.Add(amt)
+	supply.Total = supply.Total.Add(amt)
+	k.SetSupply(ctx, supply)
+}
+
+// DeflateLiquid records coins removed from accounts. It panics if more coins
+// are removed than accounts are recorded to hold.
+func (k Keeper) DeflateLiquid(ctx sdk.Context, amt sdk.Coins) {
+	if amt.IsZero() {
+		return
+	}
+	supply := k.GetSupply(ctx)
+	supply.Liquid = mustSubSupply(supply.Liquid, amt, "liquid")
+	supply.Total = mustSubSupply(supply.Total, amt, "total")
+	k.SetSupply(ctx, supply)
+}
+
+// InflateModules records coins added to the pools of modules
+func (k Keeper) InflateModules(ctx sdk.Context, amt sdk.Coins) {
+	if amt.IsZero() {
+		return
+	}
+	supply := k.GetSupply(ctx)
+	supply.Modules = supply.Modules.Add(amt)
+	supply.Total = supply.Total.Add(amt)
+	k.SetSupply(ctx, supply)
+}
+
+// DeflateModules records coins removed from the pools of modules. It panics if
+// more coins are removed than modules are recorded to hold.
+func (k Keeper) DeflateModules(ctx sdk.Context, amt sdk.Coins) {
+	if amt.IsZero() {
+		return
+	}
+	supply := k.GetSupply(ctx)
+	supply.Modules = mustSubSupply(supply.Modules, amt, "module held")
+	supply.Total = mustSubSupply(supply.Total, amt, "total")
+	k.SetSupply(ctx, supply)
+}
+
+func mustSubSupply(supply, amt sdk.Coins, kind string) sdk.Coins {
+	res, hasNeg := supply.SafeSub(amt)
+	if hasNeg {
+		panic(fmt.Sprintf("%s supply %s is less than the deflated amount %s", kind, supply, amt))
+	}
+	return res
+}
@@ -0,0 +1,120 @@
+package supply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func createTestInput() (sdk.Context, Keeper, auth.AccountKeeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	cms.LoadLatestVersion()
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(cms, abci.Header{}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	return ctx, NewKeeper(cdc, keySupply), ak
+}
+
+func TestInflateDeflate(t *testing.T) {
+	ctx, keeper, _ := createTestInput()
+
+	require.Equal(t, DefaultSupply(), keeper.GetSupply(ctx))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("photon", 50))
+	keeper.InflateLiquid(ctx, coins)
+	keeper.InflateModules(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 30)))
+	keeper.DeflateLiquid(ctx, sdk.NewCoins(sdk.NewInt64Coin("photon", 20)))
+	keeper.DeflateModules(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+
+	supply := keeper.GetSupply(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("photon", 30)), supply.Liquid)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 20)), supply.Modules)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 120), sdk.NewInt64Coin("photon", 30)), keeper.TotalSupply(ctx))
+	require.NoError(t, supply.ValidateBasic())
+
+	supplyOf := supply.SupplyOf("atom")
+	require.Equal(t, sdk.NewInt(100), supplyOf.Liquid)
+	require.Equal(t, sdk.NewInt(20), supplyOf.Modules)
+	require.Equal(t, sdk.NewInt(120), supplyOf.Total)
+
+	// deflating more than is recorded panics
+	require.Panics(t, func() {
+		keeper.DeflateModules(ctx, sdk.NewCoins(sdk.NewInt64Coin("photon", 1)))
+	})
+	require.Panics(t, func() {
+		keeper.DeflateLiquid(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 101)))
+	})
+}
+
+func TestInitGenesisAndInvariant(t *testing.T) {
+	ctx, keeper, ak := createTestInput()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	for _, addr := range []sdk.AccAddress{sdk.AccAddress([]byte("addr1")), sdk.AccAddress([]byte("addr2"))} {
+		acc := ak.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetCoins(coins))
+		ak.SetAccount(ctx, acc)
+	}
+
+	// an empty genesis supply is derived from the account balances
+	InitGenesis(ctx, keeper, ak, DefaultGenesisState())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), keeper.GetSupply(ctx).Liquid)
+	require.Equal(t, NewGenesisState(keeper.GetSupply(ctx)), ExportGenesis(ctx, keeper))
+
+	invariant := TotalSupplyInvariant(keeper, ak)
+	require.NoError(t, invariant(ctx))
+
+	// coins held by a module account are recorded as module held supply
+	macc := auth.NewEmptyModuleAccount("module", auth.Burner)
+	macc.AccountNumber = ak.GetNextAccountNumber(ctx)
+	require.NoError(t, macc.SetCoins(coins))
+	ak.SetAccount(ctx, macc)
+	require.Error(t, invariant(ctx))
+
+	keeper.InflateModules(ctx, coins)
+	require.NoError(t, invariant(ctx))
+
+	// coins added to an account without being recorded break the invariant
+	acc := ak.GetAccount(ctx, sdk.AccAddress([]byte("addr1")))
+	require.NoError(t, acc.SetCoins(coins.Add(coins)))
+	ak.SetAccount(ctx, acc)
+	require.Error(t, invariant(ctx))
+
+	// a supply of other denominations is reported rather than panicking
+	require.NoError(t, acc.SetCoins(coins))
+	ak.SetAccount(ctx, acc)
+	supply := keeper.GetSupply(ctx)
+	supply.Liquid = sdk.NewCoins(sdk.NewInt64Coin("photon", 200))
+	supply.Total = supply.Liquid.Add(supply.Modules)
+	keeper.SetSupply(ctx, supply)
+
+	var err error
+	require.NotPanics(t, func() { err = invariant(ctx) })
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "200atom")
+	require.Contains(t, err.Error(), "200photon")
+}
@@ -0,0 +1,104 @@
+package supply
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+var _ module.AppModule = AppModule{}
+
+// generic codec used for the supply genesis state
+var moduleCdc = codec.New()
+
+// AppModule implements an application module for the supply module.
+type AppModule struct {
+	keeper        Keeper
+	accountKeeper auth.AccountKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper, accountKeeper auth.AccountKeeper) AppModule {
+	return AppModule{
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+	}
+}
+
+// Name returns the supply module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the supply module's types for the given codec;
+// supply has no messages
+func (AppModule) RegisterCodec(_ *codec.Codec) {}
+
+// RegisterInvariants registers the supply module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	RegisterInvariants(ir, am.keeper, am.accountKeeper)
+}
+
+// Route returns the message routing key for the supply module; supply has no
+// messages
+func (AppModule) Route() string { return "" }
+
+// NewHandler returns an sdk.Handler for the supply module
+func (AppModule) NewHandler() sdk.Handler { return nil }
+
+// QuerierRoute returns the supply module's querier route name
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// NewQuerierHandler returns the supply module sdk.Querier
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the supply
+// module
+func (AppModule) DefaultGenesis() json.RawMessage {
+	return moduleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the supply module
+func (AppModule) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	if err := moduleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// InitGenesis performs genesis initialization for the supply module. It
+// returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	moduleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, am.accountKeeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the
+// supply module
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return moduleCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the supply module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the supply module. It returns no
+// validator updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
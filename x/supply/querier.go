@@ -0,0 +1,62 @@
+package supply
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Query endpoints supported by the supply querier
+const (
+	QuerySupply   = "supply"
+	QuerySupplyOf = "supply_of"
+)
+
+// NewQuerier creates a new querier for supply clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QuerySupply:
+			return querySupply(ctx, k)
+		case QuerySupplyOf:
+			return querySupplyOf(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown supply query endpoint")
+		}
+	}
+}
+
+// returns the supply of every denomination
+func querySupply(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetSupply(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+
+// QuerySupplyOfParams are the params for querying the supply of a single
+// denomination
+type QuerySupplyOfParams struct {
+	Denom string
+}
+
+// NewQuerySupplyOfParams creates a new instance of QuerySupplyOfParams
+func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
+	return QuerySupplyOfParams{Denom: denom}
+}
+
+// returns the supply of a single denomination
+func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QuerySupplyOfParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetSupply(ctx).SupplyOf(params.Denom))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
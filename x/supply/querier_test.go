@@ -0,0 +1,39 @@
+package supply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQuerier(t *testing.T) {
+	ctx, keeper, _ := createTestInput()
+	querier := NewQuerier(keeper)
+
+	keeper.InflateLiquid(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	keeper.InflateModules(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 50)))
+
+	bz, err := querier(ctx, []string{QuerySupply}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var supply Supply
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &supply))
+	require.Equal(t, keeper.GetSupply(ctx), supply)
+
+	req := abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(NewQuerySupplyOfParams("atom"))}
+	bz, err = querier(ctx, []string{QuerySupplyOf}, req)
+	require.Nil(t, err)
+	var supplyOf SupplyOf
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &supplyOf))
+	require.Equal(t, "atom", supplyOf.Denom)
+	require.Equal(t, sdk.NewInt(150), supplyOf.Total)
+
+	_, err = querier(ctx, []string{QuerySupplyOf}, abci.RequestQuery{Data: []byte("invalid")})
+	require.NotNil(t, err)
+
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,81 @@
+package supply
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Supply represents the supply of every denomination of the chain, split into
+// the coins held by accounts and the coins held by modules outside of accounts,
+// such as the staking pools, the collected fees and the distribution pools.
+type Supply struct {
+	Liquid  sdk.Coins `json:"liquid"`  // coins held by accounts
+	Modules sdk.Coins `json:"modules"` // coins held by modules
+	Total   sdk.Coins `json:"total"`   // total supply, liquid and module held
+}
+
+// NewSupply creates a new Supply instance
+func NewSupply(liquid, modules sdk.Coins) Supply {
+	return Supply{
+		Liquid:  liquid,
+		Modules: modules,
+		Total:   liquid.Add(modules),
+	}
+}
+
+// DefaultSupply creates an empty Supply
+func DefaultSupply() Supply {
+	return NewSupply(sdk.NewCoins(), sdk.NewCoins())
+}
+
+// SupplyOf returns the supply amounts of a single denomination
+func (supply Supply) SupplyOf(denom string) SupplyOf {
+	return SupplyOf{
+		Denom:   denom,
+		Liquid:  supply.Liquid.AmountOf(denom),
+		Modules: supply.Modules.AmountOf(denom),
+		Total:   supply.Total.AmountOf(denom),
+	}
+}
+
+// ValidateBasic validates the supply coins and checks that the total supply is
+// the sum of the liquid and module held supply.
+func (supply Supply) ValidateBasic() error {
+	if !supply.Liquid.IsValid() {
+		return fmt.Errorf("invalid liquid supply: %s", supply.Liquid)
+	}
+	if !supply.Modules.IsValid() {
+		return fmt.Errorf("invalid module held supply: %s", supply.Modules)
+	}
+	if !supply.Total.IsEqual(supply.Liquid.Add(supply.Modules)) {
+		return fmt.Errorf("total supply %s is not the sum of the liquid supply %s and the module held supply %s",
+			supply.Total, supply.Liquid, supply.Modules)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (supply Supply) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Supply:
+  Liquid:  %s
+  Modules: %s
+  Total:   %s`, supply.Liquid, supply.Modules, supply.Total))
+}
+
+// SupplyOf represents the supply of a single denomination
+type SupplyOf struct {
+	Denom   string  `json:"denom"`
+	Liquid  sdk.Int `json:"liquid"`
+	Modules sdk.Int `json:"modules"`
+	Total   sdk.Int `json:"total"`
+}
+
+// String implements the Stringer interface
+func (supply SupplyOf) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Supply of %s:
+  Liquid:  %s
+  Modules: %s
+  Total:   %s`, supply.Denom, supply.Liquid, supply.Modules, supply.Total))
+}
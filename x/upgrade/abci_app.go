@@ -0,0 +1,39 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker applies the pending upgrade plan once it is due. If this binary
+// has no handler for the upgrade, it halts the chain by panicking, so that the
+// operator can switch to the binary implementing the upgrade, which then runs
+// its handler when replaying the block.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	handler, ok := k.upgradeHandlers[plan.Name]
+	if !plan.ShouldExecute(ctx) {
+		// a binary with the handler must not run before the upgrade is due
+		if ok {
+			panic(fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE %q - in binary but not executed on chain", plan.Name))
+		}
+		return
+	}
+
+	logger := ctx.Logger().With("module", "x/upgrade")
+	if !ok {
+		msg := fmt.Sprintf("UPGRADE %q NEEDED at %s: %s", plan.Name, plan.dueAt(), plan.Info)
+		logger.Error(msg)
+		panic(msg)
+	}
+
+	logger.Info(fmt.Sprintf("applying upgrade %q at %s", plan.Name, plan.dueAt()))
+	handler(ctx, plan)
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan.Name)
+}
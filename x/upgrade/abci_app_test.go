@@ -0,0 +1,134 @@
+package upgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func createTestInput() (sdk.Context, Keeper) {
+	key := sdk.NewKVStoreKey(StoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	cms.LoadLatestVersion()
+
+	header := abci.Header{Height: 10, Time: time.Unix(1000, 0).UTC()}
+	ctx := sdk.NewContext(cms, header, false, log.NewNopLogger())
+	return ctx, NewKeeper(codec.New(), key, DefaultCodespace)
+}
+
+func TestScheduleUpgrade(t *testing.T) {
+	ctx, keeper := createTestInput()
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// invalid or past plans are rejected
+	require.Error(t, keeper.ScheduleUpgrade(ctx, Plan{Height: 20}))
+	require.Error(t, keeper.ScheduleUpgrade(ctx, Plan{Name: "test", Height: 10}))
+	require.Error(t, keeper.ScheduleUpgrade(ctx, Plan{Name: "test", Time: ctx.BlockHeader().Time}))
+
+	plan := Plan{Name: "test", Height: 20}
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, plan))
+	got, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, got)
+
+	// a new plan replaces the pending one
+	plan = Plan{Name: "test2", Time: ctx.BlockHeader().Time.Add(time.Hour), Info: "info"}
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, plan))
+	got, found = keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, got)
+
+	keeper.ClearUpgradePlan(ctx)
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestBeginBlockerHaltsWithoutHandler(t *testing.T) {
+	ctx, keeper := createTestInput()
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, Plan{Name: "test", Height: 11}))
+
+	// nothing happens before the upgrade is due
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+
+	ctx = ctx.WithBlockHeight(11)
+	require.Panics(t, func() { BeginBlocker(ctx, keeper) })
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+}
+
+func TestBeginBlockerAppliesUpgrade(t *testing.T) {
+	ctx, keeper := createTestInput()
+	plan := Plan{Name: "test", Time: ctx.BlockHeader().Time.Add(time.Minute)}
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, plan))
+
+	called := false
+	keeper.SetUpgradeHandler("test", func(ctx sdk.Context, p Plan) {
+		require.Equal(t, plan, p)
+		called = true
+	})
+
+	// a binary with the handler panics if it is run before the upgrade is due
+	require.Panics(t, func() { BeginBlocker(ctx, keeper) })
+	require.False(t, called)
+
+	header := ctx.BlockHeader()
+	header.Height = 11
+	header.Time = header.Time.Add(time.Minute)
+	ctx = ctx.WithBlockHeader(header).WithBlockHeight(header.Height)
+	BeginBlocker(ctx, keeper)
+	require.True(t, called)
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	require.Equal(t, int64(11), keeper.GetDoneHeight(ctx, "test"))
+
+	// an applied upgrade cannot be scheduled again
+	err := keeper.ScheduleUpgrade(ctx, Plan{Name: "test", Height: 20})
+	require.Error(t, err)
+	require.Equal(t, CodeAlreadyUpgraded, err.Code())
+}
+
+func TestQuerier(t *testing.T) {
+	ctx, keeper := createTestInput()
+	querier := NewQuerier(keeper)
+
+	res, err := querier(ctx, []string{QueryCurrent}, abci.RequestQuery{})
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	plan := Plan{Name: "test", Height: 11}
+	require.NoError(t, keeper.ScheduleUpgrade(ctx, plan))
+	res, err = querier(ctx, []string{QueryCurrent}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var got Plan
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &got))
+	require.Equal(t, plan, got)
+
+	keeper.SetUpgradeHandler("test", func(sdk.Context, Plan) {})
+	BeginBlocker(ctx.WithBlockHeight(11), keeper)
+
+	bz := keeper.cdc.MustMarshalJSON(NewQueryAppliedParams("test"))
+	res, err = querier(ctx, []string{QueryApplied}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+	var height int64
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &height))
+	require.Equal(t, int64(11), height)
+
+	_, err = querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// GetCmdQueryPlan implements the command to query the pending upgrade plan.
+func GetCmdQueryPlan(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Query the upgrade plan (if one exists)",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the pending upgrade plan, which is scheduled by a passed software
+upgrade proposal. The chain halts once the plan is due, unless the running
+binary implements the upgrade:
+
+$ gaiacli query upgrade plan
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryCurrent)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan upgrade.Plan
+			cdc.MustUnmarshalJSON(res, &plan)
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements the command to query the height an upgrade
+// was applied at.
+func GetCmdQueryApplied(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Short: "Query the height an upgrade was applied at",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Query the height the named upgrade was applied at:
+
+$ gaiacli query upgrade applied v2
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(upgrade.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryApplied)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			cdc.MustUnmarshalJSON(res, &height)
+			if height == 0 {
+				return fmt.Errorf("upgrade %s has not been applied", args[0])
+			}
+
+			fmt.Println(height)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+const (
+	flagTitle         = "title"
+	flagDescription   = "description"
+	flagDeposit       = "deposit"
+	flagUpgradeHeight = "upgrade-height"
+	flagUpgradeTime   = "upgrade-time"
+	flagUpgradeInfo   = "upgrade-info"
+)
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a
+// software upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name] (--upgrade-height [height] | --upgrade-time [time]) [--upgrade-info [info]]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(`
+Submit a software upgrade proposal along with an initial deposit. The upgrade
+plan is scheduled when the proposal passes; it is due either at a height or at
+a time, given in RFC3339 format:
+
+$ gaiacli tx gov submit-proposal software-upgrade v2 --upgrade-height=1000 --title="Upgrade" --description="Upgrade to v2" --deposit="10test" --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			plan, err := parsePlan(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := upgrade.NewSoftwareUpgradeProposal(viper.GetString(flagTitle), viper.GetString(flagDescription), plan)
+			msg := gov.NewMsgSubmitProposal(content, from, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "title of proposal")
+	cmd.Flags().String(flagDescription, "", "description of proposal")
+	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagUpgradeHeight, "", "height the upgrade is due at")
+	cmd.Flags().String(flagUpgradeTime, "", "time the upgrade is due at, in RFC3339 format")
+	cmd.Flags().String(flagUpgradeInfo, "", "information about the upgrade, such as where to get the new binary")
+
+	return cmd
+}
+
+func parsePlan(name string) (plan upgrade.Plan, err error) {
+	plan.Name = name
+	plan.Info = viper.GetString(flagUpgradeInfo)
+
+	if height := viper.GetString(flagUpgradeHeight); height != "" {
+		plan.Height, err = strconv.ParseInt(height, 10, 64)
+		if err != nil {
+			return plan, fmt.Errorf("invalid --%s: %s", flagUpgradeHeight, err)
+		}
+	}
+
+	if t := viper.GetString(flagUpgradeTime); t != "" {
+		plan.Time, err = time.Parse(time.RFC3339, t)
+		if err != nil {
+			return plan, fmt.Errorf("invalid --%s: %s", flagUpgradeTime, err)
+		}
+	}
+
+	return plan, nil
+}
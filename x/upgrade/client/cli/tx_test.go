@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+func TestParsePlan(t *testing.T) {
+	viper.Set(flagUpgradeHeight, "1000")
+	viper.Set(flagUpgradeInfo, "info")
+	plan, err := parsePlan("v2")
+	require.NoError(t, err)
+	require.Equal(t, upgrade.Plan{Name: "v2", Height: 1000, Info: "info"}, plan)
+
+	viper.Set(flagUpgradeHeight, "")
+	viper.Set(flagUpgradeTime, "2019-01-01T00:00:00Z")
+	plan, err = parsePlan("v2")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), plan.Time)
+
+	viper.Set(flagUpgradeTime, "tomorrow")
+	_, err = parsePlan("v2")
+	require.Error(t, err)
+
+	viper.Set(flagUpgradeTime, "")
+	viper.Set(flagUpgradeHeight, "high")
+	_, err = parsePlan("v2")
+	require.Error(t, err)
+
+	viper.Set(flagUpgradeHeight, "")
+	viper.Set(flagUpgradeInfo, "")
+}
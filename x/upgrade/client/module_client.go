@@ -0,0 +1,45 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	// Group upgrade queries under a subcommand
+	upgradeQueryCmd := &cobra.Command{
+		Use:   upgrade.ModuleName,
+		Short: "Querying commands for the upgrade module",
+	}
+
+	upgradeQueryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryPlan(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryApplied(mc.storeKey, mc.cdc),
+	)...)
+
+	return upgradeQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module; upgrades are
+// scheduled through software upgrade proposals of the gov module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   upgrade.ModuleName,
+		Short: "Upgrade transaction subcommands",
+	}
+}
@@ -0,0 +1,125 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// RegisterRoutes registers upgrade-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, queryRoute string) {
+	r.HandleFunc(
+		"/upgrade/plan",
+		planHandlerFn(cliCtx, cdc, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/upgrade/applied/{name}",
+		appliedHandlerFn(cliCtx, cdc, queryRoute),
+	).Methods("GET")
+}
+
+// HTTP request handler to query the pending upgrade plan
+func planHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryCurrent)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// HTTP request handler to query the height an upgrade was applied at
+func appliedHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		name := mux.Vars(r)["name"]
+
+		bz, err := cdc.MarshalJSON(upgrade.NewQueryAppliedParams(name))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryApplied)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// SoftwareUpgradeProposalReq defines the properties of a software upgrade
+// proposal request's body.
+type SoftwareUpgradeProposalReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req"`
+	Title          string         `json:"title"`           // Title of the proposal
+	Description    string         `json:"description"`     // Description of the proposal
+	Plan           upgrade.Plan   `json:"plan"`            // Upgrade plan scheduled when the proposal passes
+	Proposer       sdk.AccAddress `json:"proposer"`        // Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit"` // Coins to add to the proposal's deposit
+}
+
+// ProposalRESTHandler returns the REST handler submitting software upgrade
+// proposals, registered by the gov module under
+// /gov/proposals/software_upgrade
+func ProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "software_upgrade",
+		Handler:  postProposalHandlerFn(cliCtx, cdc),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SoftwareUpgradeProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := upgrade.NewSoftwareUpgradeProposal(req.Title, req.Description, req.Plan)
+		msg := gov.NewMsgSubmitProposal(content, req.Proposer, req.InitialDeposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
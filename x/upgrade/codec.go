@@ -0,0 +1,12 @@
+package upgrade
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the upgrade types on the given codec.
+// SoftwareUpgradeProposal keeps the name it had in x/gov, so that proposals in
+// state and in exported genesis files still decode.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "gov/SoftwareUpgradeProposal", nil)
+}
@@ -0,0 +1,23 @@
+//nolint
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan     sdk.CodeType = 1
+	CodeAlreadyUpgraded sdk.CodeType = 2
+)
+
+// Error constructors
+
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, "invalid upgrade plan: "+msg)
+}
+
+func ErrAlreadyUpgraded(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeAlreadyUpgraded, "upgrade "+name+" has already been applied")
+}
@@ -0,0 +1,114 @@
+package upgrade
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the upgrade module
+	ModuleName = "upgrade"
+
+	// StoreKey is the store key string for upgrade
+	StoreKey = ModuleName
+
+	// RouterKey is the route of software upgrade proposals
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for upgrade
+	QuerierRoute = ModuleName
+)
+
+// Keys for upgrade store
+var (
+	PlanKey       = []byte{0x00} // key for the pending upgrade plan
+	DoneKeyPrefix = []byte{0x01} // prefix for the heights applied upgrades were applied at
+)
+
+// GetDoneKey gets the key for the height an upgrade was applied at
+func GetDoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
+
+// Handler migrates the state of the application for an upgrade. It is run in
+// the BeginBlock of the block the upgrade is due at, before any other module.
+type Handler func(ctx sdk.Context, plan Plan)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	upgradeHandlers map[string]Handler
+	codespace       sdk.CodespaceType
+}
+
+// NewKeeper creates a new upgrade Keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:        key,
+		cdc:             cdc,
+		upgradeHandlers: make(map[string]Handler),
+		codespace:       codespace,
+	}
+}
+
+// SetUpgradeHandler registers the handler of the upgrade with the given name.
+// A binary registers the handlers of the upgrades it implements; the chain
+// halts at any planned upgrade without a registered handler.
+func (k Keeper) SetUpgradeHandler(name string, handler Handler) {
+	k.upgradeHandlers[name] = handler
+}
+
+// ScheduleUpgrade schedules an upgrade, replacing any pending plan. The plan
+// must be due after the current block, and must not reuse the name of an
+// applied upgrade.
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+	if plan.ShouldExecute(ctx) {
+		return ErrInvalidPlan(k.codespace, "upgrade cannot be scheduled in the past")
+	}
+	if k.GetDoneHeight(ctx, plan.Name) != 0 {
+		return ErrAlreadyUpgraded(k.codespace, plan.Name)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PlanKey, k.cdc.MustMarshalBinaryLengthPrefixed(plan))
+	return nil
+}
+
+// GetUpgradePlan returns the pending upgrade plan, if any
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan Plan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan removes the pending upgrade plan, if any
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PlanKey)
+}
+
+// GetDoneHeight returns the height the upgrade with the given name was applied
+// at, or 0 if it has not been applied
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDoneKey(name))
+	if bz == nil {
+		return 0
+	}
+	var height int64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &height)
+	return height
+}
+
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetDoneKey(name), k.cdc.MustMarshalBinaryLengthPrefixed(ctx.BlockHeight()))
+}
@@ -0,0 +1,84 @@
+package upgrade
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var _ module.AppModule = AppModule{}
+
+// AppModule implements an application module for the upgrade module.
+type AppModule struct {
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		keeper: keeper,
+	}
+}
+
+// Name returns the upgrade module's name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the upgrade module's types for the given codec
+func (AppModule) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterInvariants registers the upgrade module invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// Route returns the message routing key for the upgrade module; upgrades are
+// scheduled through governance, so upgrade has no messages
+func (AppModule) Route() string { return "" }
+
+// NewHandler returns an sdk.Handler for the upgrade module
+func (AppModule) NewHandler() sdk.Handler { return nil }
+
+// QuerierRoute returns the upgrade module's querier route name
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// NewQuerierHandler returns the upgrade module sdk.Querier
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the upgrade
+// module; upgrade has no genesis state
+func (AppModule) DefaultGenesis() json.RawMessage { return nil }
+
+// ValidateGenesis performs genesis state validation for the upgrade module
+func (AppModule) ValidateGenesis(_ json.RawMessage) error { return nil }
+
+// InitGenesis performs genesis initialization for the upgrade module. It
+// returns no validator updates.
+func (AppModule) InitGenesis(_ sdk.Context, _ json.RawMessage) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the
+// upgrade module
+func (AppModule) ExportGenesis(_ sdk.Context) json.RawMessage { return nil }
+
+// BeginBlock returns the begin blocker for the upgrade module
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	BeginBlocker(ctx, am.keeper)
+	return sdk.EmptyTags()
+}
+
+// EndBlock returns the end blocker for the upgrade module. It returns no
+// validator updates.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
@@ -0,0 +1,66 @@
+package upgrade
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Plan specifies information about a planned upgrade and when it should occur
+type Plan struct {
+	// Name of the upgrade; the new binary registers its upgrade handler under
+	// this name
+	Name string `json:"name"`
+
+	// Time at or after which the upgrade is applied; must not be set together
+	// with Height
+	Time time.Time `json:"time"`
+
+	// Height at which the upgrade is applied; must not be set together with
+	// Time
+	Height int64 `json:"height"`
+
+	// Any application specific information about the upgrade, such as where
+	// to download the new binary
+	Info string `json:"info"`
+}
+
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name: %s
+  %s
+  Info: %s`, p.Name, p.dueAt(), p.Info)
+}
+
+// ValidateBasic does basic validation of the plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(p.Name) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+	if p.Height < 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height cannot be negative")
+	}
+	if p.Time.IsZero() && p.Height == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "must set either time or height")
+	}
+	if !p.Time.IsZero() && p.Height != 0 {
+		return ErrInvalidPlan(DefaultCodespace, "cannot set both time and height")
+	}
+	return nil
+}
+
+// ShouldExecute returns true if the plan is due at the block of the context
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	if !p.Time.IsZero() {
+		return !ctx.BlockHeader().Time.Before(p.Time)
+	}
+	return ctx.BlockHeight() >= p.Height
+}
+
+func (p Plan) dueAt() string {
+	if !p.Time.IsZero() {
+		return fmt.Sprintf("Time: %s", p.Time.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("Height: %d", p.Height)
+}
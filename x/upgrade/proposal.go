@@ -0,0 +1,56 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+const (
+	// ProposalTypeSoftwareUpgrade defines the type for a SoftwareUpgradeProposal
+	ProposalTypeSoftwareUpgrade = "SoftwareUpgrade"
+)
+
+// Assert SoftwareUpgradeProposal implements gov.ProposalContent at compile-time
+var _ gov.ProposalContent = SoftwareUpgradeProposal{}
+
+func init() {
+	gov.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "gov/SoftwareUpgradeProposal")
+}
+
+// SoftwareUpgradeProposal schedules its upgrade plan when it passes
+type SoftwareUpgradeProposal struct {
+	gov.TextProposal
+	Plan Plan `json:"plan"` //  Upgrade plan to schedule
+}
+
+// NewSoftwareUpgradeProposal creates a new software upgrade proposal
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{
+		TextProposal: gov.NewTextProposal(title, description),
+		Plan:         plan,
+	}
+}
+
+// ProposalRoute returns the routing key of a software upgrade proposal
+func (sup SoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a software upgrade proposal
+func (sup SoftwareUpgradeProposal) ProposalType() string { return ProposalTypeSoftwareUpgrade }
+
+// ValidateBasic runs basic stateless validity checks
+func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := gov.ValidateAbstract(DefaultCodespace, sup); err != nil {
+		return err
+	}
+	return sup.Plan.ValidateBasic()
+}
+
+func (sup SoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  Upgrade:     %s at %s
+`, sup.Title, sup.Description, sup.Plan.Name, sup.Plan.dueAt())
+}
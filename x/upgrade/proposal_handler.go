@@ -0,0 +1,24 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// NewSoftwareUpgradeProposalHandler returns the gov.Handler scheduling the
+// plans of passed software upgrade proposals. The plan may have become invalid
+// during the voting period, e.g. by being due already, in which case the
+// proposal fails and no upgrade is scheduled.
+func NewSoftwareUpgradeProposalHandler(k Keeper) gov.Handler {
+	return func(ctx sdk.Context, content gov.ProposalContent) sdk.Error {
+		switch c := content.(type) {
+		case SoftwareUpgradeProposal:
+			return k.ScheduleUpgrade(ctx, c.Plan)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
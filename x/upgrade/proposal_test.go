@@ -0,0 +1,74 @@
+package upgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func TestSoftwareUpgradeProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		title      string
+		plan       Plan
+		expectPass bool
+	}{
+		{"Test Proposal", Plan{Name: "v2", Height: 100}, true},
+		{"Test Proposal", Plan{Name: "v2", Time: time.Now()}, true},
+		{"", Plan{Name: "v2", Height: 100}, false},
+		{"Test Proposal", Plan{Height: 100}, false},
+		{"Test Proposal", Plan{Name: "v2"}, false},
+		{"Test Proposal", Plan{Name: "v2", Height: 100, Time: time.Now()}, false},
+	}
+
+	for i, tc := range tests {
+		proposal := NewSoftwareUpgradeProposal(tc.title, "the purpose of this proposal is to test", tc.plan)
+		if tc.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestSoftwareUpgradeProposalHandler(t *testing.T) {
+	ctx, keeper := createTestInput()
+	handler := NewSoftwareUpgradeProposalHandler(keeper)
+
+	// the plan is scheduled when the proposal passes
+	plan := Plan{Name: "test", Height: 100}
+	require.NoError(t, handler(ctx, NewSoftwareUpgradeProposal("Test", "description", plan)))
+	scheduled, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, scheduled)
+
+	// the proposal fails if its plan is due already
+	err := handler(ctx, NewSoftwareUpgradeProposal("Test", "description", Plan{Name: "test2", Height: 10}))
+	require.Error(t, err)
+
+	// other proposal contents are not handled
+	require.Error(t, handler(ctx, gov.NewTextProposal("Test", "description")))
+}
+
+// legacySoftwareUpgradeProposal is the software upgrade proposal content
+// formerly defined by x/gov
+type legacySoftwareUpgradeProposal struct {
+	gov.TextProposal
+}
+
+func TestLegacySoftwareUpgradeProposalDecoding(t *testing.T) {
+	legacyCdc := codec.New()
+	legacyCdc.RegisterConcrete(legacySoftwareUpgradeProposal{}, "gov/SoftwareUpgradeProposal", nil)
+	bz := legacyCdc.MustMarshalBinaryBare(legacySoftwareUpgradeProposal{gov.NewTextProposal("Test", "description")})
+
+	cdc := codec.New()
+	cdc.RegisterInterface((*gov.ProposalContent)(nil), nil)
+	RegisterCodec(cdc)
+
+	var content gov.ProposalContent
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &content))
+	require.Equal(t, NewSoftwareUpgradeProposal("Test", "description", Plan{}), content)
+}
@@ -0,0 +1,67 @@
+package upgrade
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Query endpoints supported by the upgrade querier
+const (
+	QueryCurrent = "current"
+	QueryApplied = "applied"
+)
+
+// NewQuerier creates a new querier for upgrade clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryCurrent:
+			return queryCurrent(ctx, k)
+		case QueryApplied:
+			return queryApplied(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+// returns the pending plan, or no data if there is none
+func queryCurrent(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return nil, nil
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, plan)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}
+
+// QueryAppliedParams are the params for querying the height an upgrade was
+// applied at
+type QueryAppliedParams struct {
+	Name string
+}
+
+// NewQueryAppliedParams creates a new instance of QueryAppliedParams
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{Name: name}
+}
+
+// returns the height the upgrade was applied at, 0 if it was not applied
+func queryApplied(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAppliedParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetDoneHeight(ctx, params.Name))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+	return res, nil
+}